@@ -19,12 +19,58 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	VocabularyService_GetVocabularies_FullMethodName    = "/vocabulary.VocabularyService/GetVocabularies"
-	VocabularyService_CreateVocabulary_FullMethodName   = "/vocabulary.VocabularyService/CreateVocabulary"
-	VocabularyService_UpdateVocabulary_FullMethodName   = "/vocabulary.VocabularyService/UpdateVocabulary"
-	VocabularyService_DeleteVocabulary_FullMethodName   = "/vocabulary.VocabularyService/DeleteVocabulary"
-	VocabularyService_GetVocabularyById_FullMethodName  = "/vocabulary.VocabularyService/GetVocabularyById"
-	VocabularyService_GetVocabularyStats_FullMethodName = "/vocabulary.VocabularyService/GetVocabularyStats"
+	VocabularyService_GetVocabularies_FullMethodName            = "/vocabulary.VocabularyService/GetVocabularies"
+	VocabularyService_CreateVocabulary_FullMethodName           = "/vocabulary.VocabularyService/CreateVocabulary"
+	VocabularyService_UpdateVocabulary_FullMethodName           = "/vocabulary.VocabularyService/UpdateVocabulary"
+	VocabularyService_DeleteVocabulary_FullMethodName           = "/vocabulary.VocabularyService/DeleteVocabulary"
+	VocabularyService_GetVocabularyById_FullMethodName          = "/vocabulary.VocabularyService/GetVocabularyById"
+	VocabularyService_GetVocabularyStats_FullMethodName         = "/vocabulary.VocabularyService/GetVocabularyStats"
+	VocabularyService_ReviewVocabulary_FullMethodName           = "/vocabulary.VocabularyService/ReviewVocabulary"
+	VocabularyService_GetDueVocabularies_FullMethodName         = "/vocabulary.VocabularyService/GetDueVocabularies"
+	VocabularyService_GetReviewHistory_FullMethodName           = "/vocabulary.VocabularyService/GetReviewHistory"
+	VocabularyService_GenerateQuiz_FullMethodName               = "/vocabulary.VocabularyService/GenerateQuiz"
+	VocabularyService_SubmitQuiz_FullMethodName                 = "/vocabulary.VocabularyService/SubmitQuiz"
+	VocabularyService_GenerateClozeExercises_FullMethodName     = "/vocabulary.VocabularyService/GenerateClozeExercises"
+	VocabularyService_GradeCloze_FullMethodName                 = "/vocabulary.VocabularyService/GradeCloze"
+	VocabularyService_SetDailyGoal_FullMethodName               = "/vocabulary.VocabularyService/SetDailyGoal"
+	VocabularyService_ListTags_FullMethodName                   = "/vocabulary.VocabularyService/ListTags"
+	VocabularyService_RenameTag_FullMethodName                  = "/vocabulary.VocabularyService/RenameTag"
+	VocabularyService_DeleteTag_FullMethodName                  = "/vocabulary.VocabularyService/DeleteTag"
+	VocabularyService_CreateDeck_FullMethodName                 = "/vocabulary.VocabularyService/CreateDeck"
+	VocabularyService_GetDecks_FullMethodName                   = "/vocabulary.VocabularyService/GetDecks"
+	VocabularyService_GetDeckById_FullMethodName                = "/vocabulary.VocabularyService/GetDeckById"
+	VocabularyService_UpdateDeck_FullMethodName                 = "/vocabulary.VocabularyService/UpdateDeck"
+	VocabularyService_DeleteDeck_FullMethodName                 = "/vocabulary.VocabularyService/DeleteDeck"
+	VocabularyService_AddVocabulariesToDeck_FullMethodName      = "/vocabulary.VocabularyService/AddVocabulariesToDeck"
+	VocabularyService_RemoveVocabulariesFromDeck_FullMethodName = "/vocabulary.VocabularyService/RemoveVocabulariesFromDeck"
+	VocabularyService_MoveVocabularies_FullMethodName           = "/vocabulary.VocabularyService/MoveVocabularies"
+	VocabularyService_CopyVocabularies_FullMethodName           = "/vocabulary.VocabularyService/CopyVocabularies"
+	VocabularyService_GetDeckStats_FullMethodName               = "/vocabulary.VocabularyService/GetDeckStats"
+	VocabularyService_FindDuplicates_FullMethodName             = "/vocabulary.VocabularyService/FindDuplicates"
+	VocabularyService_MergeVocabularies_FullMethodName          = "/vocabulary.VocabularyService/MergeVocabularies"
+	VocabularyService_ImportVocabularies_FullMethodName         = "/vocabulary.VocabularyService/ImportVocabularies"
+	VocabularyService_ExportVocabularies_FullMethodName         = "/vocabulary.VocabularyService/ExportVocabularies"
+	VocabularyService_BackupAccount_FullMethodName              = "/vocabulary.VocabularyService/BackupAccount"
+	VocabularyService_RestoreAccount_FullMethodName             = "/vocabulary.VocabularyService/RestoreAccount"
+	VocabularyService_SuggestWords_FullMethodName               = "/vocabulary.VocabularyService/SuggestWords"
+	VocabularyService_CreateSavedSearch_FullMethodName          = "/vocabulary.VocabularyService/CreateSavedSearch"
+	VocabularyService_GetSavedSearches_FullMethodName           = "/vocabulary.VocabularyService/GetSavedSearches"
+	VocabularyService_UpdateSavedSearch_FullMethodName          = "/vocabulary.VocabularyService/UpdateSavedSearch"
+	VocabularyService_DeleteSavedSearch_FullMethodName          = "/vocabulary.VocabularyService/DeleteSavedSearch"
+	VocabularyService_GetSavedSearchResults_FullMethodName      = "/vocabulary.VocabularyService/GetSavedSearchResults"
+	VocabularyService_BatchCreateVocabularies_FullMethodName    = "/vocabulary.VocabularyService/BatchCreateVocabularies"
+	VocabularyService_BatchUpdateVocabularies_FullMethodName    = "/vocabulary.VocabularyService/BatchUpdateVocabularies"
+	VocabularyService_BatchDeleteVocabularies_FullMethodName    = "/vocabulary.VocabularyService/BatchDeleteVocabularies"
+	VocabularyService_UpdateVocabulariesByFilter_FullMethodName = "/vocabulary.VocabularyService/UpdateVocabulariesByFilter"
+	VocabularyService_ListTrash_FullMethodName                  = "/vocabulary.VocabularyService/ListTrash"
+	VocabularyService_RestoreVocabulary_FullMethodName          = "/vocabulary.VocabularyService/RestoreVocabulary"
+	VocabularyService_PurgeTrash_FullMethodName                 = "/vocabulary.VocabularyService/PurgeTrash"
+	VocabularyService_GetVocabularyRevisions_FullMethodName     = "/vocabulary.VocabularyService/GetVocabularyRevisions"
+	VocabularyService_RevertVocabulary_FullMethodName           = "/vocabulary.VocabularyService/RevertVocabulary"
+	VocabularyService_GetStatusStages_FullMethodName            = "/vocabulary.VocabularyService/GetStatusStages"
+	VocabularyService_SetStatusStages_FullMethodName            = "/vocabulary.VocabularyService/SetStatusStages"
+	VocabularyService_GetStatusTransitions_FullMethodName       = "/vocabulary.VocabularyService/GetStatusTransitions"
+	VocabularyService_GetProgressTimeline_FullMethodName        = "/vocabulary.VocabularyService/GetProgressTimeline"
 )
 
 // VocabularyServiceClient is the client API for VocabularyService service.
@@ -39,12 +85,95 @@ type VocabularyServiceClient interface {
 	CreateVocabulary(ctx context.Context, in *CreateVocabularyRequest, opts ...grpc.CallOption) (*VocabularyResponse, error)
 	// Update an existing vocabulary entry
 	UpdateVocabulary(ctx context.Context, in *UpdateVocabularyRequest, opts ...grpc.CallOption) (*VocabularyResponse, error)
-	// Delete a vocabulary entry
+	// Move a vocabulary entry to the trash
 	DeleteVocabulary(ctx context.Context, in *DeleteVocabularyRequest, opts ...grpc.CallOption) (*DeleteVocabularyResponse, error)
 	// Get vocabulary by ID
 	GetVocabularyById(ctx context.Context, in *GetVocabularyByIdRequest, opts ...grpc.CallOption) (*VocabularyResponse, error)
 	// Get vocabulary statistics
 	GetVocabularyStats(ctx context.Context, in *GetVocabularyStatsRequest, opts ...grpc.CallOption) (*VocabularyStatsResponse, error)
+	// Record a recall grade for a vocabulary entry and reschedule its next review
+	ReviewVocabulary(ctx context.Context, in *ReviewVocabularyRequest, opts ...grpc.CallOption) (*VocabularyResponse, error)
+	// Get the vocabularies due for review today
+	GetDueVocabularies(ctx context.Context, in *GetDueVocabulariesRequest, opts ...grpc.CallOption) (*GetDueVocabulariesResponse, error)
+	// Get the review attempts recorded for a vocabulary entry
+	GetReviewHistory(ctx context.Context, in *GetReviewHistoryRequest, opts ...grpc.CallOption) (*GetReviewHistoryResponse, error)
+	// Generate a multiple-choice quiz from the user's vocabularies
+	GenerateQuiz(ctx context.Context, in *GenerateQuizRequest, opts ...grpc.CallOption) (*GenerateQuizResponse, error)
+	// Grade quiz answers and feed the results into each entry's schedule
+	SubmitQuiz(ctx context.Context, in *SubmitQuizRequest, opts ...grpc.CallOption) (*SubmitQuizResponse, error)
+	// Build fill-in-the-blank exercises from the user's example sentences
+	GenerateClozeExercises(ctx context.Context, in *GenerateClozeRequest, opts ...grpc.CallOption) (*GenerateClozeResponse, error)
+	// Grade typed cloze answers, tolerating small typos
+	GradeCloze(ctx context.Context, in *GradeClozeRequest, opts ...grpc.CallOption) (*GradeClozeResponse, error)
+	// Set the number of words the user aims to add or review each day
+	SetDailyGoal(ctx context.Context, in *SetDailyGoalRequest, opts ...grpc.CallOption) (*DailyGoalResponse, error)
+	// List the user's tags with the number of entries using each
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	// Rename a tag, merging it into an existing tag with the same name
+	RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*TagResponse, error)
+	// Delete a tag and remove it from every entry
+	DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error)
+	// Create a deck
+	CreateDeck(ctx context.Context, in *CreateDeckRequest, opts ...grpc.CallOption) (*DeckResponse, error)
+	// List the user's decks
+	GetDecks(ctx context.Context, in *GetDecksRequest, opts ...grpc.CallOption) (*GetDecksResponse, error)
+	// Get a deck by ID
+	GetDeckById(ctx context.Context, in *DeckRequest, opts ...grpc.CallOption) (*DeckResponse, error)
+	// Update a deck's details and study settings
+	UpdateDeck(ctx context.Context, in *UpdateDeckRequest, opts ...grpc.CallOption) (*DeckResponse, error)
+	// Delete a deck, keeping its vocabulary entries
+	DeleteDeck(ctx context.Context, in *DeckRequest, opts ...grpc.CallOption) (*DeleteDeckResponse, error)
+	// Add vocabulary entries to a deck
+	AddVocabulariesToDeck(ctx context.Context, in *DeckVocabulariesRequest, opts ...grpc.CallOption) (*DeckVocabulariesResponse, error)
+	// Remove vocabulary entries from a deck
+	RemoveVocabulariesFromDeck(ctx context.Context, in *DeckVocabulariesRequest, opts ...grpc.CallOption) (*DeckVocabulariesResponse, error)
+	// Move vocabulary entries from one deck to another
+	MoveVocabularies(ctx context.Context, in *TransferVocabulariesRequest, opts ...grpc.CallOption) (*DeckVocabulariesResponse, error)
+	// Copy vocabulary entries into another deck, keeping them in the source deck
+	CopyVocabularies(ctx context.Context, in *TransferVocabulariesRequest, opts ...grpc.CallOption) (*DeckVocabulariesResponse, error)
+	// Get statistics for a single deck
+	GetDeckStats(ctx context.Context, in *DeckRequest, opts ...grpc.CallOption) (*DeckStatsResponse, error)
+	// Find groups of entries with the same normalised word
+	FindDuplicates(ctx context.Context, in *FindDuplicatesRequest, opts ...grpc.CallOption) (*FindDuplicatesResponse, error)
+	// Merge entries into one, keeping the target and deleting the sources
+	MergeVocabularies(ctx context.Context, in *MergeVocabulariesRequest, opts ...grpc.CallOption) (*VocabularyResponse, error)
+	// Import vocabularies from a CSV or TSV file streamed in chunks
+	ImportVocabularies(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportVocabulariesRequest, ImportVocabulariesResponse], error)
+	// Export the user's vocabularies as CSV, TSV, Anki notes or JSON, streamed in chunks
+	ExportVocabularies(ctx context.Context, in *ExportVocabulariesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportVocabulariesResponse], error)
+	// Back up the whole account as a versioned JSON archive
+	BackupAccount(ctx context.Context, in *BackupAccountRequest, opts ...grpc.CallOption) (*BackupAccountResponse, error)
+	// Restore an account from a BackupAccount archive
+	RestoreAccount(ctx context.Context, in *RestoreAccountRequest, opts ...grpc.CallOption) (*RestoreAccountResponse, error)
+	// Suggest the user's words starting with a prefix, for search-as-you-type
+	SuggestWords(ctx context.Context, in *SuggestWordsRequest, opts ...grpc.CallOption) (*SuggestWordsResponse, error)
+	// Saved searches: named filters listed as collections that follow changes to the entries
+	CreateSavedSearch(ctx context.Context, in *CreateSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearchResponse, error)
+	GetSavedSearches(ctx context.Context, in *GetSavedSearchesRequest, opts ...grpc.CallOption) (*GetSavedSearchesResponse, error)
+	UpdateSavedSearch(ctx context.Context, in *UpdateSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearchResponse, error)
+	DeleteSavedSearch(ctx context.Context, in *SavedSearchRequest, opts ...grpc.CallOption) (*DeleteSavedSearchResponse, error)
+	// List the entries currently matching a saved search
+	GetSavedSearchResults(ctx context.Context, in *GetSavedSearchResultsRequest, opts ...grpc.CallOption) (*GetVocabulariesResponse, error)
+	// Batches: many entries created, updated or deleted in one transaction,
+	// all or nothing or best effort, with a result per item
+	BatchCreateVocabularies(ctx context.Context, in *BatchCreateVocabulariesRequest, opts ...grpc.CallOption) (*BatchVocabulariesResponse, error)
+	BatchUpdateVocabularies(ctx context.Context, in *BatchUpdateVocabulariesRequest, opts ...grpc.CallOption) (*BatchVocabulariesResponse, error)
+	BatchDeleteVocabularies(ctx context.Context, in *BatchDeleteVocabulariesRequest, opts ...grpc.CallOption) (*BatchVocabulariesResponse, error)
+	// Change every entry matching a filter, or preview the change with dry_run
+	UpdateVocabulariesByFilter(ctx context.Context, in *UpdateVocabulariesByFilterRequest, opts ...grpc.CallOption) (*UpdateVocabulariesByFilterResponse, error)
+	// Trash: deleted entries are kept for a retention period before they are purged
+	ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	RestoreVocabulary(ctx context.Context, in *RestoreVocabularyRequest, opts ...grpc.CallOption) (*VocabularyResponse, error)
+	PurgeTrash(ctx context.Context, in *PurgeTrashRequest, opts ...grpc.CallOption) (*PurgeTrashResponse, error)
+	// Edit history of an entry's word, meaning, example and status
+	GetVocabularyRevisions(ctx context.Context, in *GetVocabularyRevisionsRequest, opts ...grpc.CallOption) (*GetVocabularyRevisionsResponse, error)
+	RevertVocabulary(ctx context.Context, in *RevertVocabularyRequest, opts ...grpc.CallOption) (*VocabularyResponse, error)
+	// Learning stages: the user's names for the statuses, and the moves allowed between them
+	GetStatusStages(ctx context.Context, in *GetStatusStagesRequest, opts ...grpc.CallOption) (*StatusStagesResponse, error)
+	SetStatusStages(ctx context.Context, in *SetStatusStagesRequest, opts ...grpc.CallOption) (*StatusStagesResponse, error)
+	GetStatusTransitions(ctx context.Context, in *GetStatusTransitionsRequest, opts ...grpc.CallOption) (*GetStatusTransitionsResponse, error)
+	// Get how many words were in each status at the end of each day or week, from the recorded transitions
+	GetProgressTimeline(ctx context.Context, in *GetProgressTimelineRequest, opts ...grpc.CallOption) (*GetProgressTimelineResponse, error)
 }
 
 type vocabularyServiceClient struct {
@@ -115,6 +244,478 @@ func (c *vocabularyServiceClient) GetVocabularyStats(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *vocabularyServiceClient) ReviewVocabulary(ctx context.Context, in *ReviewVocabularyRequest, opts ...grpc.CallOption) (*VocabularyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VocabularyResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_ReviewVocabulary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) GetDueVocabularies(ctx context.Context, in *GetDueVocabulariesRequest, opts ...grpc.CallOption) (*GetDueVocabulariesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDueVocabulariesResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_GetDueVocabularies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) GetReviewHistory(ctx context.Context, in *GetReviewHistoryRequest, opts ...grpc.CallOption) (*GetReviewHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReviewHistoryResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_GetReviewHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) GenerateQuiz(ctx context.Context, in *GenerateQuizRequest, opts ...grpc.CallOption) (*GenerateQuizResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateQuizResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_GenerateQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) SubmitQuiz(ctx context.Context, in *SubmitQuizRequest, opts ...grpc.CallOption) (*SubmitQuizResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitQuizResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_SubmitQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) GenerateClozeExercises(ctx context.Context, in *GenerateClozeRequest, opts ...grpc.CallOption) (*GenerateClozeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateClozeResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_GenerateClozeExercises_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) GradeCloze(ctx context.Context, in *GradeClozeRequest, opts ...grpc.CallOption) (*GradeClozeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GradeClozeResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_GradeCloze_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) SetDailyGoal(ctx context.Context, in *SetDailyGoalRequest, opts ...grpc.CallOption) (*DailyGoalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DailyGoalResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_SetDailyGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_ListTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*TagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_RenameTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTagResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_DeleteTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) CreateDeck(ctx context.Context, in *CreateDeckRequest, opts ...grpc.CallOption) (*DeckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeckResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_CreateDeck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) GetDecks(ctx context.Context, in *GetDecksRequest, opts ...grpc.CallOption) (*GetDecksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDecksResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_GetDecks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) GetDeckById(ctx context.Context, in *DeckRequest, opts ...grpc.CallOption) (*DeckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeckResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_GetDeckById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) UpdateDeck(ctx context.Context, in *UpdateDeckRequest, opts ...grpc.CallOption) (*DeckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeckResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_UpdateDeck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) DeleteDeck(ctx context.Context, in *DeckRequest, opts ...grpc.CallOption) (*DeleteDeckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDeckResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_DeleteDeck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) AddVocabulariesToDeck(ctx context.Context, in *DeckVocabulariesRequest, opts ...grpc.CallOption) (*DeckVocabulariesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeckVocabulariesResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_AddVocabulariesToDeck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) RemoveVocabulariesFromDeck(ctx context.Context, in *DeckVocabulariesRequest, opts ...grpc.CallOption) (*DeckVocabulariesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeckVocabulariesResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_RemoveVocabulariesFromDeck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) MoveVocabularies(ctx context.Context, in *TransferVocabulariesRequest, opts ...grpc.CallOption) (*DeckVocabulariesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeckVocabulariesResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_MoveVocabularies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) CopyVocabularies(ctx context.Context, in *TransferVocabulariesRequest, opts ...grpc.CallOption) (*DeckVocabulariesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeckVocabulariesResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_CopyVocabularies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) GetDeckStats(ctx context.Context, in *DeckRequest, opts ...grpc.CallOption) (*DeckStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeckStatsResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_GetDeckStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) FindDuplicates(ctx context.Context, in *FindDuplicatesRequest, opts ...grpc.CallOption) (*FindDuplicatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindDuplicatesResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_FindDuplicates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) MergeVocabularies(ctx context.Context, in *MergeVocabulariesRequest, opts ...grpc.CallOption) (*VocabularyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VocabularyResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_MergeVocabularies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) ImportVocabularies(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportVocabulariesRequest, ImportVocabulariesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VocabularyService_ServiceDesc.Streams[0], VocabularyService_ImportVocabularies_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportVocabulariesRequest, ImportVocabulariesResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VocabularyService_ImportVocabulariesClient = grpc.ClientStreamingClient[ImportVocabulariesRequest, ImportVocabulariesResponse]
+
+func (c *vocabularyServiceClient) ExportVocabularies(ctx context.Context, in *ExportVocabulariesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportVocabulariesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VocabularyService_ServiceDesc.Streams[1], VocabularyService_ExportVocabularies_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportVocabulariesRequest, ExportVocabulariesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VocabularyService_ExportVocabulariesClient = grpc.ServerStreamingClient[ExportVocabulariesResponse]
+
+func (c *vocabularyServiceClient) BackupAccount(ctx context.Context, in *BackupAccountRequest, opts ...grpc.CallOption) (*BackupAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BackupAccountResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_BackupAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) RestoreAccount(ctx context.Context, in *RestoreAccountRequest, opts ...grpc.CallOption) (*RestoreAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreAccountResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_RestoreAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) SuggestWords(ctx context.Context, in *SuggestWordsRequest, opts ...grpc.CallOption) (*SuggestWordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestWordsResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_SuggestWords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) CreateSavedSearch(ctx context.Context, in *CreateSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedSearchResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_CreateSavedSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) GetSavedSearches(ctx context.Context, in *GetSavedSearchesRequest, opts ...grpc.CallOption) (*GetSavedSearchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSavedSearchesResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_GetSavedSearches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) UpdateSavedSearch(ctx context.Context, in *UpdateSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedSearchResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_UpdateSavedSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) DeleteSavedSearch(ctx context.Context, in *SavedSearchRequest, opts ...grpc.CallOption) (*DeleteSavedSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSavedSearchResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_DeleteSavedSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) GetSavedSearchResults(ctx context.Context, in *GetSavedSearchResultsRequest, opts ...grpc.CallOption) (*GetVocabulariesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVocabulariesResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_GetSavedSearchResults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) BatchCreateVocabularies(ctx context.Context, in *BatchCreateVocabulariesRequest, opts ...grpc.CallOption) (*BatchVocabulariesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchVocabulariesResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_BatchCreateVocabularies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) BatchUpdateVocabularies(ctx context.Context, in *BatchUpdateVocabulariesRequest, opts ...grpc.CallOption) (*BatchVocabulariesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchVocabulariesResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_BatchUpdateVocabularies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) BatchDeleteVocabularies(ctx context.Context, in *BatchDeleteVocabulariesRequest, opts ...grpc.CallOption) (*BatchVocabulariesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchVocabulariesResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_BatchDeleteVocabularies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) UpdateVocabulariesByFilter(ctx context.Context, in *UpdateVocabulariesByFilterRequest, opts ...grpc.CallOption) (*UpdateVocabulariesByFilterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateVocabulariesByFilterResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_UpdateVocabulariesByFilter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_ListTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) RestoreVocabulary(ctx context.Context, in *RestoreVocabularyRequest, opts ...grpc.CallOption) (*VocabularyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VocabularyResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_RestoreVocabulary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) PurgeTrash(ctx context.Context, in *PurgeTrashRequest, opts ...grpc.CallOption) (*PurgeTrashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeTrashResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_PurgeTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) GetVocabularyRevisions(ctx context.Context, in *GetVocabularyRevisionsRequest, opts ...grpc.CallOption) (*GetVocabularyRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVocabularyRevisionsResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_GetVocabularyRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) RevertVocabulary(ctx context.Context, in *RevertVocabularyRequest, opts ...grpc.CallOption) (*VocabularyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VocabularyResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_RevertVocabulary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) GetStatusStages(ctx context.Context, in *GetStatusStagesRequest, opts ...grpc.CallOption) (*StatusStagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusStagesResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_GetStatusStages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) SetStatusStages(ctx context.Context, in *SetStatusStagesRequest, opts ...grpc.CallOption) (*StatusStagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusStagesResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_SetStatusStages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) GetStatusTransitions(ctx context.Context, in *GetStatusTransitionsRequest, opts ...grpc.CallOption) (*GetStatusTransitionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStatusTransitionsResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_GetStatusTransitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) GetProgressTimeline(ctx context.Context, in *GetProgressTimelineRequest, opts ...grpc.CallOption) (*GetProgressTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProgressTimelineResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_GetProgressTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VocabularyServiceServer is the server API for VocabularyService service.
 // All implementations must embed UnimplementedVocabularyServiceServer
 // for forward compatibility.
@@ -127,165 +728,1196 @@ type VocabularyServiceServer interface {
 	CreateVocabulary(context.Context, *CreateVocabularyRequest) (*VocabularyResponse, error)
 	// Update an existing vocabulary entry
 	UpdateVocabulary(context.Context, *UpdateVocabularyRequest) (*VocabularyResponse, error)
-	// Delete a vocabulary entry
+	// Move a vocabulary entry to the trash
 	DeleteVocabulary(context.Context, *DeleteVocabularyRequest) (*DeleteVocabularyResponse, error)
 	// Get vocabulary by ID
 	GetVocabularyById(context.Context, *GetVocabularyByIdRequest) (*VocabularyResponse, error)
 	// Get vocabulary statistics
 	GetVocabularyStats(context.Context, *GetVocabularyStatsRequest) (*VocabularyStatsResponse, error)
+	// Record a recall grade for a vocabulary entry and reschedule its next review
+	ReviewVocabulary(context.Context, *ReviewVocabularyRequest) (*VocabularyResponse, error)
+	// Get the vocabularies due for review today
+	GetDueVocabularies(context.Context, *GetDueVocabulariesRequest) (*GetDueVocabulariesResponse, error)
+	// Get the review attempts recorded for a vocabulary entry
+	GetReviewHistory(context.Context, *GetReviewHistoryRequest) (*GetReviewHistoryResponse, error)
+	// Generate a multiple-choice quiz from the user's vocabularies
+	GenerateQuiz(context.Context, *GenerateQuizRequest) (*GenerateQuizResponse, error)
+	// Grade quiz answers and feed the results into each entry's schedule
+	SubmitQuiz(context.Context, *SubmitQuizRequest) (*SubmitQuizResponse, error)
+	// Build fill-in-the-blank exercises from the user's example sentences
+	GenerateClozeExercises(context.Context, *GenerateClozeRequest) (*GenerateClozeResponse, error)
+	// Grade typed cloze answers, tolerating small typos
+	GradeCloze(context.Context, *GradeClozeRequest) (*GradeClozeResponse, error)
+	// Set the number of words the user aims to add or review each day
+	SetDailyGoal(context.Context, *SetDailyGoalRequest) (*DailyGoalResponse, error)
+	// List the user's tags with the number of entries using each
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	// Rename a tag, merging it into an existing tag with the same name
+	RenameTag(context.Context, *RenameTagRequest) (*TagResponse, error)
+	// Delete a tag and remove it from every entry
+	DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error)
+	// Create a deck
+	CreateDeck(context.Context, *CreateDeckRequest) (*DeckResponse, error)
+	// List the user's decks
+	GetDecks(context.Context, *GetDecksRequest) (*GetDecksResponse, error)
+	// Get a deck by ID
+	GetDeckById(context.Context, *DeckRequest) (*DeckResponse, error)
+	// Update a deck's details and study settings
+	UpdateDeck(context.Context, *UpdateDeckRequest) (*DeckResponse, error)
+	// Delete a deck, keeping its vocabulary entries
+	DeleteDeck(context.Context, *DeckRequest) (*DeleteDeckResponse, error)
+	// Add vocabulary entries to a deck
+	AddVocabulariesToDeck(context.Context, *DeckVocabulariesRequest) (*DeckVocabulariesResponse, error)
+	// Remove vocabulary entries from a deck
+	RemoveVocabulariesFromDeck(context.Context, *DeckVocabulariesRequest) (*DeckVocabulariesResponse, error)
+	// Move vocabulary entries from one deck to another
+	MoveVocabularies(context.Context, *TransferVocabulariesRequest) (*DeckVocabulariesResponse, error)
+	// Copy vocabulary entries into another deck, keeping them in the source deck
+	CopyVocabularies(context.Context, *TransferVocabulariesRequest) (*DeckVocabulariesResponse, error)
+	// Get statistics for a single deck
+	GetDeckStats(context.Context, *DeckRequest) (*DeckStatsResponse, error)
+	// Find groups of entries with the same normalised word
+	FindDuplicates(context.Context, *FindDuplicatesRequest) (*FindDuplicatesResponse, error)
+	// Merge entries into one, keeping the target and deleting the sources
+	MergeVocabularies(context.Context, *MergeVocabulariesRequest) (*VocabularyResponse, error)
+	// Import vocabularies from a CSV or TSV file streamed in chunks
+	ImportVocabularies(grpc.ClientStreamingServer[ImportVocabulariesRequest, ImportVocabulariesResponse]) error
+	// Export the user's vocabularies as CSV, TSV, Anki notes or JSON, streamed in chunks
+	ExportVocabularies(*ExportVocabulariesRequest, grpc.ServerStreamingServer[ExportVocabulariesResponse]) error
+	// Back up the whole account as a versioned JSON archive
+	BackupAccount(context.Context, *BackupAccountRequest) (*BackupAccountResponse, error)
+	// Restore an account from a BackupAccount archive
+	RestoreAccount(context.Context, *RestoreAccountRequest) (*RestoreAccountResponse, error)
+	// Suggest the user's words starting with a prefix, for search-as-you-type
+	SuggestWords(context.Context, *SuggestWordsRequest) (*SuggestWordsResponse, error)
+	// Saved searches: named filters listed as collections that follow changes to the entries
+	CreateSavedSearch(context.Context, *CreateSavedSearchRequest) (*SavedSearchResponse, error)
+	GetSavedSearches(context.Context, *GetSavedSearchesRequest) (*GetSavedSearchesResponse, error)
+	UpdateSavedSearch(context.Context, *UpdateSavedSearchRequest) (*SavedSearchResponse, error)
+	DeleteSavedSearch(context.Context, *SavedSearchRequest) (*DeleteSavedSearchResponse, error)
+	// List the entries currently matching a saved search
+	GetSavedSearchResults(context.Context, *GetSavedSearchResultsRequest) (*GetVocabulariesResponse, error)
+	// Batches: many entries created, updated or deleted in one transaction,
+	// all or nothing or best effort, with a result per item
+	BatchCreateVocabularies(context.Context, *BatchCreateVocabulariesRequest) (*BatchVocabulariesResponse, error)
+	BatchUpdateVocabularies(context.Context, *BatchUpdateVocabulariesRequest) (*BatchVocabulariesResponse, error)
+	BatchDeleteVocabularies(context.Context, *BatchDeleteVocabulariesRequest) (*BatchVocabulariesResponse, error)
+	// Change every entry matching a filter, or preview the change with dry_run
+	UpdateVocabulariesByFilter(context.Context, *UpdateVocabulariesByFilterRequest) (*UpdateVocabulariesByFilterResponse, error)
+	// Trash: deleted entries are kept for a retention period before they are purged
+	ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	RestoreVocabulary(context.Context, *RestoreVocabularyRequest) (*VocabularyResponse, error)
+	PurgeTrash(context.Context, *PurgeTrashRequest) (*PurgeTrashResponse, error)
+	// Edit history of an entry's word, meaning, example and status
+	GetVocabularyRevisions(context.Context, *GetVocabularyRevisionsRequest) (*GetVocabularyRevisionsResponse, error)
+	RevertVocabulary(context.Context, *RevertVocabularyRequest) (*VocabularyResponse, error)
+	// Learning stages: the user's names for the statuses, and the moves allowed between them
+	GetStatusStages(context.Context, *GetStatusStagesRequest) (*StatusStagesResponse, error)
+	SetStatusStages(context.Context, *SetStatusStagesRequest) (*StatusStagesResponse, error)
+	GetStatusTransitions(context.Context, *GetStatusTransitionsRequest) (*GetStatusTransitionsResponse, error)
+	// Get how many words were in each status at the end of each day or week, from the recorded transitions
+	GetProgressTimeline(context.Context, *GetProgressTimelineRequest) (*GetProgressTimelineResponse, error)
+	mustEmbedUnimplementedVocabularyServiceServer()
+}
+
+// UnimplementedVocabularyServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedVocabularyServiceServer struct{}
+
+func (UnimplementedVocabularyServiceServer) GetVocabularies(context.Context, *GetVocabulariesRequest) (*GetVocabulariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVocabularies not implemented")
+}
+func (UnimplementedVocabularyServiceServer) CreateVocabulary(context.Context, *CreateVocabularyRequest) (*VocabularyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVocabulary not implemented")
+}
+func (UnimplementedVocabularyServiceServer) UpdateVocabulary(context.Context, *UpdateVocabularyRequest) (*VocabularyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVocabulary not implemented")
+}
+func (UnimplementedVocabularyServiceServer) DeleteVocabulary(context.Context, *DeleteVocabularyRequest) (*DeleteVocabularyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVocabulary not implemented")
+}
+func (UnimplementedVocabularyServiceServer) GetVocabularyById(context.Context, *GetVocabularyByIdRequest) (*VocabularyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVocabularyById not implemented")
+}
+func (UnimplementedVocabularyServiceServer) GetVocabularyStats(context.Context, *GetVocabularyStatsRequest) (*VocabularyStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVocabularyStats not implemented")
+}
+func (UnimplementedVocabularyServiceServer) ReviewVocabulary(context.Context, *ReviewVocabularyRequest) (*VocabularyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewVocabulary not implemented")
+}
+func (UnimplementedVocabularyServiceServer) GetDueVocabularies(context.Context, *GetDueVocabulariesRequest) (*GetDueVocabulariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDueVocabularies not implemented")
+}
+func (UnimplementedVocabularyServiceServer) GetReviewHistory(context.Context, *GetReviewHistoryRequest) (*GetReviewHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReviewHistory not implemented")
+}
+func (UnimplementedVocabularyServiceServer) GenerateQuiz(context.Context, *GenerateQuizRequest) (*GenerateQuizResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateQuiz not implemented")
+}
+func (UnimplementedVocabularyServiceServer) SubmitQuiz(context.Context, *SubmitQuizRequest) (*SubmitQuizResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitQuiz not implemented")
+}
+func (UnimplementedVocabularyServiceServer) GenerateClozeExercises(context.Context, *GenerateClozeRequest) (*GenerateClozeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateClozeExercises not implemented")
+}
+func (UnimplementedVocabularyServiceServer) GradeCloze(context.Context, *GradeClozeRequest) (*GradeClozeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GradeCloze not implemented")
+}
+func (UnimplementedVocabularyServiceServer) SetDailyGoal(context.Context, *SetDailyGoalRequest) (*DailyGoalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDailyGoal not implemented")
+}
+func (UnimplementedVocabularyServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedVocabularyServiceServer) RenameTag(context.Context, *RenameTagRequest) (*TagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameTag not implemented")
+}
+func (UnimplementedVocabularyServiceServer) DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+}
+func (UnimplementedVocabularyServiceServer) CreateDeck(context.Context, *CreateDeckRequest) (*DeckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDeck not implemented")
+}
+func (UnimplementedVocabularyServiceServer) GetDecks(context.Context, *GetDecksRequest) (*GetDecksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDecks not implemented")
+}
+func (UnimplementedVocabularyServiceServer) GetDeckById(context.Context, *DeckRequest) (*DeckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeckById not implemented")
+}
+func (UnimplementedVocabularyServiceServer) UpdateDeck(context.Context, *UpdateDeckRequest) (*DeckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDeck not implemented")
+}
+func (UnimplementedVocabularyServiceServer) DeleteDeck(context.Context, *DeckRequest) (*DeleteDeckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDeck not implemented")
+}
+func (UnimplementedVocabularyServiceServer) AddVocabulariesToDeck(context.Context, *DeckVocabulariesRequest) (*DeckVocabulariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddVocabulariesToDeck not implemented")
+}
+func (UnimplementedVocabularyServiceServer) RemoveVocabulariesFromDeck(context.Context, *DeckVocabulariesRequest) (*DeckVocabulariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveVocabulariesFromDeck not implemented")
+}
+func (UnimplementedVocabularyServiceServer) MoveVocabularies(context.Context, *TransferVocabulariesRequest) (*DeckVocabulariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveVocabularies not implemented")
+}
+func (UnimplementedVocabularyServiceServer) CopyVocabularies(context.Context, *TransferVocabulariesRequest) (*DeckVocabulariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyVocabularies not implemented")
+}
+func (UnimplementedVocabularyServiceServer) GetDeckStats(context.Context, *DeckRequest) (*DeckStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeckStats not implemented")
+}
+func (UnimplementedVocabularyServiceServer) FindDuplicates(context.Context, *FindDuplicatesRequest) (*FindDuplicatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindDuplicates not implemented")
+}
+func (UnimplementedVocabularyServiceServer) MergeVocabularies(context.Context, *MergeVocabulariesRequest) (*VocabularyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeVocabularies not implemented")
+}
+func (UnimplementedVocabularyServiceServer) ImportVocabularies(grpc.ClientStreamingServer[ImportVocabulariesRequest, ImportVocabulariesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportVocabularies not implemented")
+}
+func (UnimplementedVocabularyServiceServer) ExportVocabularies(*ExportVocabulariesRequest, grpc.ServerStreamingServer[ExportVocabulariesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportVocabularies not implemented")
+}
+func (UnimplementedVocabularyServiceServer) BackupAccount(context.Context, *BackupAccountRequest) (*BackupAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackupAccount not implemented")
+}
+func (UnimplementedVocabularyServiceServer) RestoreAccount(context.Context, *RestoreAccountRequest) (*RestoreAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAccount not implemented")
+}
+func (UnimplementedVocabularyServiceServer) SuggestWords(context.Context, *SuggestWordsRequest) (*SuggestWordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestWords not implemented")
+}
+func (UnimplementedVocabularyServiceServer) CreateSavedSearch(context.Context, *CreateSavedSearchRequest) (*SavedSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSavedSearch not implemented")
+}
+func (UnimplementedVocabularyServiceServer) GetSavedSearches(context.Context, *GetSavedSearchesRequest) (*GetSavedSearchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSavedSearches not implemented")
+}
+func (UnimplementedVocabularyServiceServer) UpdateSavedSearch(context.Context, *UpdateSavedSearchRequest) (*SavedSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSavedSearch not implemented")
+}
+func (UnimplementedVocabularyServiceServer) DeleteSavedSearch(context.Context, *SavedSearchRequest) (*DeleteSavedSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSavedSearch not implemented")
+}
+func (UnimplementedVocabularyServiceServer) GetSavedSearchResults(context.Context, *GetSavedSearchResultsRequest) (*GetVocabulariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSavedSearchResults not implemented")
+}
+func (UnimplementedVocabularyServiceServer) BatchCreateVocabularies(context.Context, *BatchCreateVocabulariesRequest) (*BatchVocabulariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateVocabularies not implemented")
+}
+func (UnimplementedVocabularyServiceServer) BatchUpdateVocabularies(context.Context, *BatchUpdateVocabulariesRequest) (*BatchVocabulariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateVocabularies not implemented")
+}
+func (UnimplementedVocabularyServiceServer) BatchDeleteVocabularies(context.Context, *BatchDeleteVocabulariesRequest) (*BatchVocabulariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteVocabularies not implemented")
+}
+func (UnimplementedVocabularyServiceServer) UpdateVocabulariesByFilter(context.Context, *UpdateVocabulariesByFilterRequest) (*UpdateVocabulariesByFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVocabulariesByFilter not implemented")
+}
+func (UnimplementedVocabularyServiceServer) ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedVocabularyServiceServer) RestoreVocabulary(context.Context, *RestoreVocabularyRequest) (*VocabularyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreVocabulary not implemented")
+}
+func (UnimplementedVocabularyServiceServer) PurgeTrash(context.Context, *PurgeTrashRequest) (*PurgeTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeTrash not implemented")
+}
+func (UnimplementedVocabularyServiceServer) GetVocabularyRevisions(context.Context, *GetVocabularyRevisionsRequest) (*GetVocabularyRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVocabularyRevisions not implemented")
+}
+func (UnimplementedVocabularyServiceServer) RevertVocabulary(context.Context, *RevertVocabularyRequest) (*VocabularyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertVocabulary not implemented")
+}
+func (UnimplementedVocabularyServiceServer) GetStatusStages(context.Context, *GetStatusStagesRequest) (*StatusStagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatusStages not implemented")
+}
+func (UnimplementedVocabularyServiceServer) SetStatusStages(context.Context, *SetStatusStagesRequest) (*StatusStagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStatusStages not implemented")
+}
+func (UnimplementedVocabularyServiceServer) GetStatusTransitions(context.Context, *GetStatusTransitionsRequest) (*GetStatusTransitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatusTransitions not implemented")
+}
+func (UnimplementedVocabularyServiceServer) GetProgressTimeline(context.Context, *GetProgressTimelineRequest) (*GetProgressTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProgressTimeline not implemented")
+}
+func (UnimplementedVocabularyServiceServer) mustEmbedUnimplementedVocabularyServiceServer() {}
+func (UnimplementedVocabularyServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeVocabularyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VocabularyServiceServer will
+// result in compilation errors.
+type UnsafeVocabularyServiceServer interface {
 	mustEmbedUnimplementedVocabularyServiceServer()
 }
 
-// UnimplementedVocabularyServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedVocabularyServiceServer struct{}
-
-func (UnimplementedVocabularyServiceServer) GetVocabularies(context.Context, *GetVocabulariesRequest) (*GetVocabulariesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVocabularies not implemented")
+func RegisterVocabularyServiceServer(s grpc.ServiceRegistrar, srv VocabularyServiceServer) {
+	// If the following call pancis, it indicates UnimplementedVocabularyServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&VocabularyService_ServiceDesc, srv)
+}
+
+func _VocabularyService_GetVocabularies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVocabulariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).GetVocabularies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_GetVocabularies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).GetVocabularies(ctx, req.(*GetVocabulariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_CreateVocabulary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVocabularyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).CreateVocabulary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_CreateVocabulary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).CreateVocabulary(ctx, req.(*CreateVocabularyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_UpdateVocabulary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVocabularyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).UpdateVocabulary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_UpdateVocabulary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).UpdateVocabulary(ctx, req.(*UpdateVocabularyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_DeleteVocabulary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVocabularyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).DeleteVocabulary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_DeleteVocabulary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).DeleteVocabulary(ctx, req.(*DeleteVocabularyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_GetVocabularyById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVocabularyByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).GetVocabularyById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_GetVocabularyById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).GetVocabularyById(ctx, req.(*GetVocabularyByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_GetVocabularyStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVocabularyStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).GetVocabularyStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_GetVocabularyStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).GetVocabularyStats(ctx, req.(*GetVocabularyStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_ReviewVocabulary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewVocabularyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).ReviewVocabulary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_ReviewVocabulary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).ReviewVocabulary(ctx, req.(*ReviewVocabularyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_GetDueVocabularies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDueVocabulariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).GetDueVocabularies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_GetDueVocabularies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).GetDueVocabularies(ctx, req.(*GetDueVocabulariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_GetReviewHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).GetReviewHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_GetReviewHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).GetReviewHistory(ctx, req.(*GetReviewHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_GenerateQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).GenerateQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_GenerateQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).GenerateQuiz(ctx, req.(*GenerateQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_SubmitQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).SubmitQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_SubmitQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).SubmitQuiz(ctx, req.(*SubmitQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_GenerateClozeExercises_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateClozeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).GenerateClozeExercises(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_GenerateClozeExercises_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).GenerateClozeExercises(ctx, req.(*GenerateClozeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_GradeCloze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradeClozeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).GradeCloze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_GradeCloze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).GradeCloze(ctx, req.(*GradeClozeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_SetDailyGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDailyGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).SetDailyGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_SetDailyGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).SetDailyGoal(ctx, req.(*SetDailyGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_RenameTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).RenameTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_RenameTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).RenameTag(ctx, req.(*RenameTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).DeleteTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_DeleteTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).DeleteTag(ctx, req.(*DeleteTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_CreateDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).CreateDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_CreateDeck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).CreateDeck(ctx, req.(*CreateDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_GetDecks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDecksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).GetDecks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_GetDecks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).GetDecks(ctx, req.(*GetDecksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_GetDeckById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).GetDeckById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_GetDeckById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).GetDeckById(ctx, req.(*DeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_UpdateDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).UpdateDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_UpdateDeck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).UpdateDeck(ctx, req.(*UpdateDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_DeleteDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).DeleteDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_DeleteDeck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).DeleteDeck(ctx, req.(*DeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_AddVocabulariesToDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeckVocabulariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).AddVocabulariesToDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_AddVocabulariesToDeck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).AddVocabulariesToDeck(ctx, req.(*DeckVocabulariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_RemoveVocabulariesFromDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeckVocabulariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).RemoveVocabulariesFromDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_RemoveVocabulariesFromDeck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).RemoveVocabulariesFromDeck(ctx, req.(*DeckVocabulariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_MoveVocabularies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferVocabulariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).MoveVocabularies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_MoveVocabularies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).MoveVocabularies(ctx, req.(*TransferVocabulariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_CopyVocabularies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferVocabulariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).CopyVocabularies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_CopyVocabularies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).CopyVocabularies(ctx, req.(*TransferVocabulariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_GetDeckStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).GetDeckStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_GetDeckStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).GetDeckStats(ctx, req.(*DeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_FindDuplicates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindDuplicatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).FindDuplicates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_FindDuplicates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).FindDuplicates(ctx, req.(*FindDuplicatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_MergeVocabularies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeVocabulariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).MergeVocabularies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_MergeVocabularies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).MergeVocabularies(ctx, req.(*MergeVocabulariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_ImportVocabularies_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(VocabularyServiceServer).ImportVocabularies(&grpc.GenericServerStream[ImportVocabulariesRequest, ImportVocabulariesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VocabularyService_ImportVocabulariesServer = grpc.ClientStreamingServer[ImportVocabulariesRequest, ImportVocabulariesResponse]
+
+func _VocabularyService_ExportVocabularies_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportVocabulariesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VocabularyServiceServer).ExportVocabularies(m, &grpc.GenericServerStream[ExportVocabulariesRequest, ExportVocabulariesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VocabularyService_ExportVocabulariesServer = grpc.ServerStreamingServer[ExportVocabulariesResponse]
+
+func _VocabularyService_BackupAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).BackupAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_BackupAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).BackupAccount(ctx, req.(*BackupAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_RestoreAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).RestoreAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_RestoreAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).RestoreAccount(ctx, req.(*RestoreAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_SuggestWords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestWordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).SuggestWords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_SuggestWords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).SuggestWords(ctx, req.(*SuggestWordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_CreateSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).CreateSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_CreateSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).CreateSavedSearch(ctx, req.(*CreateSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_GetSavedSearches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSavedSearchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).GetSavedSearches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_GetSavedSearches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).GetSavedSearches(ctx, req.(*GetSavedSearchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_UpdateSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).UpdateSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_UpdateSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).UpdateSavedSearch(ctx, req.(*UpdateSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_DeleteSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).DeleteSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_DeleteSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).DeleteSavedSearch(ctx, req.(*SavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_GetSavedSearchResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSavedSearchResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).GetSavedSearchResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_GetSavedSearchResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).GetSavedSearchResults(ctx, req.(*GetSavedSearchResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVocabularyServiceServer) CreateVocabulary(context.Context, *CreateVocabularyRequest) (*VocabularyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateVocabulary not implemented")
+
+func _VocabularyService_BatchCreateVocabularies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateVocabulariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).BatchCreateVocabularies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_BatchCreateVocabularies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).BatchCreateVocabularies(ctx, req.(*BatchCreateVocabulariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVocabularyServiceServer) UpdateVocabulary(context.Context, *UpdateVocabularyRequest) (*VocabularyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateVocabulary not implemented")
+
+func _VocabularyService_BatchUpdateVocabularies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateVocabulariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).BatchUpdateVocabularies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_BatchUpdateVocabularies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).BatchUpdateVocabularies(ctx, req.(*BatchUpdateVocabulariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVocabularyServiceServer) DeleteVocabulary(context.Context, *DeleteVocabularyRequest) (*DeleteVocabularyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteVocabulary not implemented")
+
+func _VocabularyService_BatchDeleteVocabularies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteVocabulariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).BatchDeleteVocabularies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_BatchDeleteVocabularies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).BatchDeleteVocabularies(ctx, req.(*BatchDeleteVocabulariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVocabularyServiceServer) GetVocabularyById(context.Context, *GetVocabularyByIdRequest) (*VocabularyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVocabularyById not implemented")
+
+func _VocabularyService_UpdateVocabulariesByFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVocabulariesByFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).UpdateVocabulariesByFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_UpdateVocabulariesByFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).UpdateVocabulariesByFilter(ctx, req.(*UpdateVocabulariesByFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVocabularyServiceServer) GetVocabularyStats(context.Context, *GetVocabularyStatsRequest) (*VocabularyStatsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVocabularyStats not implemented")
+
+func _VocabularyService_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_ListTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).ListTrash(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVocabularyServiceServer) mustEmbedUnimplementedVocabularyServiceServer() {}
-func (UnimplementedVocabularyServiceServer) testEmbeddedByValue()                           {}
 
-// UnsafeVocabularyServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to VocabularyServiceServer will
-// result in compilation errors.
-type UnsafeVocabularyServiceServer interface {
-	mustEmbedUnimplementedVocabularyServiceServer()
+func _VocabularyService_RestoreVocabulary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreVocabularyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).RestoreVocabulary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_RestoreVocabulary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).RestoreVocabulary(ctx, req.(*RestoreVocabularyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterVocabularyServiceServer(s grpc.ServiceRegistrar, srv VocabularyServiceServer) {
-	// If the following call pancis, it indicates UnimplementedVocabularyServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _VocabularyService_PurgeTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&VocabularyService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).PurgeTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_PurgeTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).PurgeTrash(ctx, req.(*PurgeTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _VocabularyService_GetVocabularies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetVocabulariesRequest)
+func _VocabularyService_GetVocabularyRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVocabularyRevisionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VocabularyServiceServer).GetVocabularies(ctx, in)
+		return srv.(VocabularyServiceServer).GetVocabularyRevisions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VocabularyService_GetVocabularies_FullMethodName,
+		FullMethod: VocabularyService_GetVocabularyRevisions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VocabularyServiceServer).GetVocabularies(ctx, req.(*GetVocabulariesRequest))
+		return srv.(VocabularyServiceServer).GetVocabularyRevisions(ctx, req.(*GetVocabularyRevisionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VocabularyService_CreateVocabulary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateVocabularyRequest)
+func _VocabularyService_RevertVocabulary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertVocabularyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VocabularyServiceServer).CreateVocabulary(ctx, in)
+		return srv.(VocabularyServiceServer).RevertVocabulary(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VocabularyService_CreateVocabulary_FullMethodName,
+		FullMethod: VocabularyService_RevertVocabulary_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VocabularyServiceServer).CreateVocabulary(ctx, req.(*CreateVocabularyRequest))
+		return srv.(VocabularyServiceServer).RevertVocabulary(ctx, req.(*RevertVocabularyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VocabularyService_UpdateVocabulary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateVocabularyRequest)
+func _VocabularyService_GetStatusStages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatusStagesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VocabularyServiceServer).UpdateVocabulary(ctx, in)
+		return srv.(VocabularyServiceServer).GetStatusStages(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VocabularyService_UpdateVocabulary_FullMethodName,
+		FullMethod: VocabularyService_GetStatusStages_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VocabularyServiceServer).UpdateVocabulary(ctx, req.(*UpdateVocabularyRequest))
+		return srv.(VocabularyServiceServer).GetStatusStages(ctx, req.(*GetStatusStagesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VocabularyService_DeleteVocabulary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteVocabularyRequest)
+func _VocabularyService_SetStatusStages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStatusStagesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VocabularyServiceServer).DeleteVocabulary(ctx, in)
+		return srv.(VocabularyServiceServer).SetStatusStages(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VocabularyService_DeleteVocabulary_FullMethodName,
+		FullMethod: VocabularyService_SetStatusStages_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VocabularyServiceServer).DeleteVocabulary(ctx, req.(*DeleteVocabularyRequest))
+		return srv.(VocabularyServiceServer).SetStatusStages(ctx, req.(*SetStatusStagesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VocabularyService_GetVocabularyById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetVocabularyByIdRequest)
+func _VocabularyService_GetStatusTransitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatusTransitionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VocabularyServiceServer).GetVocabularyById(ctx, in)
+		return srv.(VocabularyServiceServer).GetStatusTransitions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VocabularyService_GetVocabularyById_FullMethodName,
+		FullMethod: VocabularyService_GetStatusTransitions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VocabularyServiceServer).GetVocabularyById(ctx, req.(*GetVocabularyByIdRequest))
+		return srv.(VocabularyServiceServer).GetStatusTransitions(ctx, req.(*GetStatusTransitionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VocabularyService_GetVocabularyStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetVocabularyStatsRequest)
+func _VocabularyService_GetProgressTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProgressTimelineRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VocabularyServiceServer).GetVocabularyStats(ctx, in)
+		return srv.(VocabularyServiceServer).GetProgressTimeline(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VocabularyService_GetVocabularyStats_FullMethodName,
+		FullMethod: VocabularyService_GetProgressTimeline_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VocabularyServiceServer).GetVocabularyStats(ctx, req.(*GetVocabularyStatsRequest))
+		return srv.(VocabularyServiceServer).GetProgressTimeline(ctx, req.(*GetProgressTimelineRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -321,7 +1953,194 @@ var VocabularyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetVocabularyStats",
 			Handler:    _VocabularyService_GetVocabularyStats_Handler,
 		},
+		{
+			MethodName: "ReviewVocabulary",
+			Handler:    _VocabularyService_ReviewVocabulary_Handler,
+		},
+		{
+			MethodName: "GetDueVocabularies",
+			Handler:    _VocabularyService_GetDueVocabularies_Handler,
+		},
+		{
+			MethodName: "GetReviewHistory",
+			Handler:    _VocabularyService_GetReviewHistory_Handler,
+		},
+		{
+			MethodName: "GenerateQuiz",
+			Handler:    _VocabularyService_GenerateQuiz_Handler,
+		},
+		{
+			MethodName: "SubmitQuiz",
+			Handler:    _VocabularyService_SubmitQuiz_Handler,
+		},
+		{
+			MethodName: "GenerateClozeExercises",
+			Handler:    _VocabularyService_GenerateClozeExercises_Handler,
+		},
+		{
+			MethodName: "GradeCloze",
+			Handler:    _VocabularyService_GradeCloze_Handler,
+		},
+		{
+			MethodName: "SetDailyGoal",
+			Handler:    _VocabularyService_SetDailyGoal_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _VocabularyService_ListTags_Handler,
+		},
+		{
+			MethodName: "RenameTag",
+			Handler:    _VocabularyService_RenameTag_Handler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    _VocabularyService_DeleteTag_Handler,
+		},
+		{
+			MethodName: "CreateDeck",
+			Handler:    _VocabularyService_CreateDeck_Handler,
+		},
+		{
+			MethodName: "GetDecks",
+			Handler:    _VocabularyService_GetDecks_Handler,
+		},
+		{
+			MethodName: "GetDeckById",
+			Handler:    _VocabularyService_GetDeckById_Handler,
+		},
+		{
+			MethodName: "UpdateDeck",
+			Handler:    _VocabularyService_UpdateDeck_Handler,
+		},
+		{
+			MethodName: "DeleteDeck",
+			Handler:    _VocabularyService_DeleteDeck_Handler,
+		},
+		{
+			MethodName: "AddVocabulariesToDeck",
+			Handler:    _VocabularyService_AddVocabulariesToDeck_Handler,
+		},
+		{
+			MethodName: "RemoveVocabulariesFromDeck",
+			Handler:    _VocabularyService_RemoveVocabulariesFromDeck_Handler,
+		},
+		{
+			MethodName: "MoveVocabularies",
+			Handler:    _VocabularyService_MoveVocabularies_Handler,
+		},
+		{
+			MethodName: "CopyVocabularies",
+			Handler:    _VocabularyService_CopyVocabularies_Handler,
+		},
+		{
+			MethodName: "GetDeckStats",
+			Handler:    _VocabularyService_GetDeckStats_Handler,
+		},
+		{
+			MethodName: "FindDuplicates",
+			Handler:    _VocabularyService_FindDuplicates_Handler,
+		},
+		{
+			MethodName: "MergeVocabularies",
+			Handler:    _VocabularyService_MergeVocabularies_Handler,
+		},
+		{
+			MethodName: "BackupAccount",
+			Handler:    _VocabularyService_BackupAccount_Handler,
+		},
+		{
+			MethodName: "RestoreAccount",
+			Handler:    _VocabularyService_RestoreAccount_Handler,
+		},
+		{
+			MethodName: "SuggestWords",
+			Handler:    _VocabularyService_SuggestWords_Handler,
+		},
+		{
+			MethodName: "CreateSavedSearch",
+			Handler:    _VocabularyService_CreateSavedSearch_Handler,
+		},
+		{
+			MethodName: "GetSavedSearches",
+			Handler:    _VocabularyService_GetSavedSearches_Handler,
+		},
+		{
+			MethodName: "UpdateSavedSearch",
+			Handler:    _VocabularyService_UpdateSavedSearch_Handler,
+		},
+		{
+			MethodName: "DeleteSavedSearch",
+			Handler:    _VocabularyService_DeleteSavedSearch_Handler,
+		},
+		{
+			MethodName: "GetSavedSearchResults",
+			Handler:    _VocabularyService_GetSavedSearchResults_Handler,
+		},
+		{
+			MethodName: "BatchCreateVocabularies",
+			Handler:    _VocabularyService_BatchCreateVocabularies_Handler,
+		},
+		{
+			MethodName: "BatchUpdateVocabularies",
+			Handler:    _VocabularyService_BatchUpdateVocabularies_Handler,
+		},
+		{
+			MethodName: "BatchDeleteVocabularies",
+			Handler:    _VocabularyService_BatchDeleteVocabularies_Handler,
+		},
+		{
+			MethodName: "UpdateVocabulariesByFilter",
+			Handler:    _VocabularyService_UpdateVocabulariesByFilter_Handler,
+		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _VocabularyService_ListTrash_Handler,
+		},
+		{
+			MethodName: "RestoreVocabulary",
+			Handler:    _VocabularyService_RestoreVocabulary_Handler,
+		},
+		{
+			MethodName: "PurgeTrash",
+			Handler:    _VocabularyService_PurgeTrash_Handler,
+		},
+		{
+			MethodName: "GetVocabularyRevisions",
+			Handler:    _VocabularyService_GetVocabularyRevisions_Handler,
+		},
+		{
+			MethodName: "RevertVocabulary",
+			Handler:    _VocabularyService_RevertVocabulary_Handler,
+		},
+		{
+			MethodName: "GetStatusStages",
+			Handler:    _VocabularyService_GetStatusStages_Handler,
+		},
+		{
+			MethodName: "SetStatusStages",
+			Handler:    _VocabularyService_SetStatusStages_Handler,
+		},
+		{
+			MethodName: "GetStatusTransitions",
+			Handler:    _VocabularyService_GetStatusTransitions_Handler,
+		},
+		{
+			MethodName: "GetProgressTimeline",
+			Handler:    _VocabularyService_GetProgressTimeline_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportVocabularies",
+			Handler:       _VocabularyService_ImportVocabularies_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportVocabularies",
+			Handler:       _VocabularyService_ExportVocabularies_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/vocabulary.proto",
 }
@@ -0,0 +1,159 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/vocal-tracker/broker-service/config"
+	"github.com/vocal-tracker/broker-service/middleware"
+	pb "github.com/vocal-tracker/broker-service/proto"
+	"google.golang.org/grpc"
+)
+
+// maxArchiveSize is the largest backup archive sent to or accepted from the
+// vocabulary service
+const maxArchiveSize = 64 << 20
+
+type AccountHandler struct {
+	cfg *config.Config
+}
+
+// Response types
+type RestoreResponse struct {
+	Success bool            `json:"success"`
+	Message string          `json:"message"`
+	Created int32           `json:"created"`
+	Skipped int32           `json:"skipped"`
+	Deleted int32           `json:"deleted"`
+	Profile *ArchiveProfile `json:"profile,omitempty"`
+}
+
+type ArchiveProfile struct {
+	ID        uint32 `json:"id"`
+	Email     string `json:"email"`
+	CreatedAt string `json:"created_at"`
+}
+
+func NewAccountHandler(cfg *config.Config) *AccountHandler {
+	return &AccountHandler{cfg: cfg}
+}
+
+// BackupAccount handles GET /account/backup
+func (a *AccountHandler) BackupAccount(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	// The profile comes from the auth service
+	profileResp, err := a.cfg.AuthServiceClient.GetProfile(ctx, &pb.GetProfileRequest{
+		UserId: user.UserID,
+	})
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to get profile", http.StatusInternalServerError)
+		return
+	}
+	if !profileResp.Success {
+		middleware.WriteErrorResponse(w, profileResp.Message, http.StatusBadRequest)
+		return
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.BackupAccountRequest{
+		UserId: user.UserID,
+		Profile: &pb.AccountProfile{
+			Id:        profileResp.User.Id,
+			Email:     profileResp.User.Email,
+			CreatedAt: profileResp.User.CreatedAt,
+		},
+	}
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := a.cfg.VocabServiceClient.BackupAccount(ctx, grpcReq, grpc.MaxCallRecvMsgSize(maxArchiveSize))
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to back up account", http.StatusInternalServerError)
+		return
+	}
+	if !resp.Success {
+		middleware.WriteErrorResponse(w, resp.Message, http.StatusBadRequest)
+		return
+	}
+
+	filename := fmt.Sprintf("vocabulary-backup-%s.json", time.Now().Format("2006-01-02"))
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	w.Write(resp.Archive)
+}
+
+// RestoreAccount handles POST /account/restore
+func (a *AccountHandler) RestoreAccount(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// The request body is the archive itself
+	archive, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxArchiveSize))
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Invalid request body or archive larger than 64MB", http.StatusBadRequest)
+		return
+	}
+	if !json.Valid(archive) {
+		middleware.WriteErrorResponse(w, "Archive is not valid JSON", http.StatusBadRequest)
+		return
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.RestoreAccountRequest{
+		UserId:  user.UserID,
+		Archive: archive,
+		Mode:    r.URL.Query().Get("mode"),
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := a.cfg.VocabServiceClient.RestoreAccount(ctx, grpcReq, grpc.MaxCallSendMsgSize(maxArchiveSize))
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to restore account", http.StatusInternalServerError)
+		return
+	}
+
+	response := RestoreResponse{
+		Success: resp.Success,
+		Message: resp.Message,
+		Created: resp.Created,
+		Skipped: resp.Skipped,
+		Deleted: resp.Deleted,
+	}
+	if resp.Profile != nil {
+		response.Profile = &ArchiveProfile{
+			ID:        resp.Profile.Id,
+			Email:     resp.Profile.Email,
+			CreatedAt: resp.Profile.CreatedAt,
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
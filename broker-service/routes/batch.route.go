@@ -0,0 +1,221 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/vocal-tracker/broker-service/middleware"
+	pb "github.com/vocal-tracker/broker-service/proto"
+)
+
+// Request types
+type BatchRequest struct {
+	// Mode is "all_or_nothing" (default) or "best_effort"
+	Mode string `json:"mode,omitempty"`
+	// A batch has exactly one of Create, Update and Delete
+	Create []CreateVocabRequest `json:"create,omitempty"`
+	Update []BatchUpdateItem    `json:"update,omitempty"`
+	Delete []uint32             `json:"delete,omitempty"`
+}
+
+// BatchUpdateItem is an update of one entry; omitted fields are left unchanged
+type BatchUpdateItem struct {
+	ID uint32 `json:"id"`
+	UpdateVocabRequest
+}
+
+type BatchDeleteRequest struct {
+	VocabularyIDs []uint32 `json:"vocabulary_ids"`
+	Mode          string   `json:"mode,omitempty"`
+}
+
+// Response types
+type BatchResponse struct {
+	Success   bool              `json:"success"`
+	Message   string            `json:"message"`
+	Results   []BatchItemResult `json:"results"`
+	Succeeded int32             `json:"succeeded"`
+	Failed    int32             `json:"failed"`
+}
+
+type BatchItemResult struct {
+	Index        int32       `json:"index"`
+	Success      bool        `json:"success"`
+	Message      string      `json:"message"`
+	VocabularyID uint32      `json:"vocabulary_id,omitempty"`
+	Vocab        *Vocabulary `json:"vocabulary,omitempty"`
+	Duplicate    bool        `json:"duplicate,omitempty"`
+	Merged       bool        `json:"merged,omitempty"`
+	Conflict     bool        `json:"conflict,omitempty"`
+}
+
+// BatchVocabularies handles POST /vocab/batch
+func (v *VocabHandler) BatchVocabularies(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse request body
+	var req BatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		middleware.WriteErrorResponse(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	operations := 0
+	for _, items := range []int{len(req.Create), len(req.Update), len(req.Delete)} {
+		if items > 0 {
+			operations++
+		}
+	}
+	if operations != 1 {
+		middleware.WriteErrorResponse(w, "Use exactly one of create, update and delete", http.StatusBadRequest)
+		return
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	var resp *pb.BatchVocabulariesResponse
+	var err error
+	switch {
+	case len(req.Create) > 0:
+		grpcReq := &pb.BatchCreateVocabulariesRequest{
+			UserId: user.UserID,
+			Mode:   req.Mode,
+			Items:  make([]*pb.CreateVocabularyRequest, len(req.Create)),
+		}
+		for i, item := range req.Create {
+			grpcReq.Items[i] = &pb.CreateVocabularyRequest{
+				UserId:      user.UserID,
+				Word:        item.Word,
+				Meaning:     item.Meaning,
+				Example:     item.Example,
+				Date:        item.Date,
+				Status:      item.Status,
+				Tags:        item.Tags,
+				DeckIds:     item.DeckIDs,
+				OnDuplicate: item.OnDuplicate,
+			}
+		}
+		resp, err = v.cfg.VocabServiceClient.BatchCreateVocabularies(ctx, grpcReq)
+	case len(req.Update) > 0:
+		grpcReq := &pb.BatchUpdateVocabulariesRequest{
+			UserId: user.UserID,
+			Mode:   req.Mode,
+			Items:  make([]*pb.UpdateVocabularyRequest, len(req.Update)),
+		}
+		for i, item := range req.Update {
+			grpcReq.Items[i] = &pb.UpdateVocabularyRequest{
+				VocabularyId:    item.ID,
+				UserId:          user.UserID,
+				Word:            item.Word,
+				Meaning:         item.Meaning,
+				Example:         item.Example,
+				Status:          item.Status,
+				ExpectedVersion: item.Version,
+			}
+			if item.Tags != nil {
+				grpcReq.Items[i].Tags = *item.Tags
+				grpcReq.Items[i].ClearTags = len(*item.Tags) == 0
+			}
+		}
+		resp, err = v.cfg.VocabServiceClient.BatchUpdateVocabularies(ctx, grpcReq)
+	default:
+		resp, err = v.cfg.VocabServiceClient.BatchDeleteVocabularies(ctx, &pb.BatchDeleteVocabulariesRequest{
+			UserId:        user.UserID,
+			VocabularyIds: req.Delete,
+			Mode:          req.Mode,
+		})
+	}
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to run batch", http.StatusInternalServerError)
+		return
+	}
+
+	writeBatchResponse(w, resp)
+}
+
+// DeleteVocabularies handles DELETE /vocab
+func (v *VocabHandler) DeleteVocabularies(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse request body
+	var req BatchDeleteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		middleware.WriteErrorResponse(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if len(req.VocabularyIDs) == 0 {
+		middleware.WriteErrorResponse(w, "vocabulary_ids is required", http.StatusBadRequest)
+		return
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.BatchDeleteVocabulariesRequest{
+		UserId:        user.UserID,
+		VocabularyIds: req.VocabularyIDs,
+		Mode:          req.Mode,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := v.cfg.VocabServiceClient.BatchDeleteVocabularies(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to delete vocabularies", http.StatusInternalServerError)
+		return
+	}
+
+	writeBatchResponse(w, resp)
+}
+
+// writeBatchResponse writes a batch gRPC response as JSON. A rolled back
+// batch is a bad request; a best effort batch succeeds even if items failed.
+func writeBatchResponse(w http.ResponseWriter, resp *pb.BatchVocabulariesResponse) {
+	results := make([]BatchItemResult, len(resp.Results))
+	for i, result := range resp.Results {
+		results[i] = BatchItemResult{
+			Index:        result.Index,
+			Success:      result.Success,
+			Message:      result.Message,
+			VocabularyID: result.VocabularyId,
+			Vocab:        toVocabulary(result.Vocabulary),
+			Duplicate:    result.Duplicate,
+			Merged:       result.Merged,
+			Conflict:     result.Conflict,
+		}
+	}
+
+	response := BatchResponse{
+		Success:   resp.Success,
+		Message:   resp.Message,
+		Results:   results,
+		Succeeded: resp.Succeeded,
+		Failed:    resp.Failed,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,111 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/vocal-tracker/broker-service/middleware"
+	pb "github.com/vocal-tracker/broker-service/proto"
+)
+
+// Request types
+type BulkUpdateRequest struct {
+	// Filter selects the entries to change; leave it out to change every entry
+	Filter     *SavedSearchFilter `json:"filter"`
+	Changes    VocabularyChanges  `json:"changes"`
+	DryRun     bool               `json:"dry_run"`
+	SampleSize int32              `json:"sample_size,omitempty"`
+}
+
+type VocabularyChanges struct {
+	Status     string   `json:"status,omitempty"`
+	AddTags    []string `json:"add_tags,omitempty"`
+	RemoveTags []string `json:"remove_tags,omitempty"`
+}
+
+// Response types
+type BulkUpdateResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+	Matched int32  `json:"matched"`
+	Updated int32  `json:"updated"`
+	// Skipped entries match the filter but cannot move to the new stage
+	Skipped int32        `json:"skipped"`
+	DryRun  bool         `json:"dry_run"`
+	Sample  []Vocabulary `json:"sample"`
+	// ErrorPosition is where filter.q is invalid, from 1
+	ErrorPosition int32 `json:"error_position,omitempty"`
+}
+
+// BulkUpdateVocabularies handles POST /vocab/bulk-update. Without
+// ?confirm=true nothing is changed: the request runs as a dry run and
+// returns the entries that would be.
+func (v *VocabHandler) BulkUpdateVocabularies(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse request body
+	var req BulkUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		middleware.WriteErrorResponse(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.UpdateVocabulariesByFilterRequest{
+		UserId: user.UserID,
+		Filter: toSavedSearchFilterProto(req.Filter),
+		Changes: &pb.VocabularyChanges{
+			Status:     req.Changes.Status,
+			AddTags:    req.Changes.AddTags,
+			RemoveTags: req.Changes.RemoveTags,
+		},
+		DryRun:     req.DryRun || r.URL.Query().Get("confirm") != "true",
+		SampleSize: req.SampleSize,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := v.cfg.VocabServiceClient.UpdateVocabulariesByFilter(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to update vocabularies", http.StatusInternalServerError)
+		return
+	}
+
+	// Convert response
+	sample := make([]Vocabulary, len(resp.Sample))
+	for i, vocab := range resp.Sample {
+		sample[i] = *toVocabulary(vocab)
+	}
+
+	response := BulkUpdateResponse{
+		Success:       resp.Success,
+		Message:       resp.Message,
+		Matched:       resp.Matched,
+		Updated:       resp.Updated,
+		Skipped:       resp.Skipped,
+		DryRun:        resp.DryRun,
+		Sample:        sample,
+		ErrorPosition: resp.ErrorPosition,
+	}
+	if resp.Success && resp.DryRun && !req.DryRun {
+		response.Message += ". Repeat with ?confirm=true to apply the changes"
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,206 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/vocal-tracker/broker-service/middleware"
+	pb "github.com/vocal-tracker/broker-service/proto"
+)
+
+// Request types
+type GradeClozeRequest struct {
+	Answers []ClozeAnswer `json:"answers"`
+}
+
+type ClozeAnswer struct {
+	VocabularyID uint32 `json:"vocabulary_id"`
+	Answer       string `json:"answer"`
+}
+
+// Response types
+type ClozeResponse struct {
+	Success  bool         `json:"success"`
+	Message  string       `json:"message"`
+	Items    []ClozeItem  `json:"items"`
+	Unusable []ClozeIssue `json:"unusable"`
+}
+
+type ClozeItem struct {
+	VocabularyID uint32 `json:"vocabulary_id"`
+	Sentence     string `json:"sentence"`
+	Hint         string `json:"hint"`
+	AnswerLength int32  `json:"answer_length"`
+}
+
+type ClozeIssue struct {
+	VocabularyID uint32 `json:"vocabulary_id"`
+	Word         string `json:"word"`
+	Reason       string `json:"reason"`
+}
+
+type ClozeGradeResponse struct {
+	Success bool          `json:"success"`
+	Message string        `json:"message"`
+	Correct int32         `json:"correct"`
+	Total   int32         `json:"total"`
+	Results []ClozeResult `json:"results"`
+}
+
+type ClozeResult struct {
+	VocabularyID uint32 `json:"vocabulary_id"`
+	Correct      bool   `json:"correct"`
+	Exact        bool   `json:"exact"`
+	Answer       string `json:"answer"`
+	Expected     string `json:"expected"`
+	Distance     int32  `json:"distance"`
+}
+
+// GetClozeExercises handles GET /vocab/cloze
+func (v *VocabHandler) GetClozeExercises(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse query parameters
+	var count int32
+	if c, err := strconv.Atoi(r.URL.Query().Get("count")); err == nil {
+		count = int32(c)
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.GenerateClozeRequest{
+		UserId: user.UserID,
+		Count:  count,
+		Status: r.URL.Query().Get("status"),
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := v.cfg.VocabServiceClient.GenerateClozeExercises(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to generate cloze exercises", http.StatusInternalServerError)
+		return
+	}
+
+	// Convert response
+	items := make([]ClozeItem, len(resp.Items))
+	for i, item := range resp.Items {
+		items[i] = ClozeItem{
+			VocabularyID: item.VocabularyId,
+			Sentence:     item.Sentence,
+			Hint:         item.Hint,
+			AnswerLength: item.AnswerLength,
+		}
+	}
+
+	unusable := make([]ClozeIssue, len(resp.Unusable))
+	for i, issue := range resp.Unusable {
+		unusable[i] = ClozeIssue{
+			VocabularyID: issue.VocabularyId,
+			Word:         issue.Word,
+			Reason:       issue.Reason,
+		}
+	}
+
+	response := ClozeResponse{
+		Success:  resp.Success,
+		Message:  resp.Message,
+		Items:    items,
+		Unusable: unusable,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+// GradeCloze handles POST /vocab/cloze/grade
+func (v *VocabHandler) GradeCloze(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse request body
+	var req GradeClozeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		middleware.WriteErrorResponse(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Validate required fields
+	if len(req.Answers) == 0 {
+		middleware.WriteErrorResponse(w, "Answers are required", http.StatusBadRequest)
+		return
+	}
+
+	// Create gRPC request
+	answers := make([]*pb.ClozeAnswer, len(req.Answers))
+	for i, answer := range req.Answers {
+		answers[i] = &pb.ClozeAnswer{
+			VocabularyId: answer.VocabularyID,
+			Answer:       answer.Answer,
+		}
+	}
+
+	grpcReq := &pb.GradeClozeRequest{
+		UserId:  user.UserID,
+		Answers: answers,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := v.cfg.VocabServiceClient.GradeCloze(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to grade cloze answers", http.StatusInternalServerError)
+		return
+	}
+
+	// Convert response
+	results := make([]ClozeResult, len(resp.Results))
+	for i, result := range resp.Results {
+		results[i] = ClozeResult{
+			VocabularyID: result.VocabularyId,
+			Correct:      result.Correct,
+			Exact:        result.Exact,
+			Answer:       result.Answer,
+			Expected:     result.Expected,
+			Distance:     result.Distance,
+		}
+	}
+
+	response := ClozeGradeResponse{
+		Success: resp.Success,
+		Message: resp.Message,
+		Correct: resp.Correct,
+		Total:   resp.Total,
+		Results: results,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
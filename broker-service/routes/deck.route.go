@@ -0,0 +1,587 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/vocal-tracker/broker-service/config"
+	"github.com/vocal-tracker/broker-service/middleware"
+	pb "github.com/vocal-tracker/broker-service/proto"
+)
+
+type DeckHandler struct {
+	cfg *config.Config
+}
+
+// Request types
+type CreateDeckRequest struct {
+	Name              string `json:"name"`
+	Description       string `json:"description"`
+	SourceLanguage    string `json:"source_language"`
+	TargetLanguage    string `json:"target_language"`
+	NewCardsPerDay    int32  `json:"new_cards_per_day"`
+	ReviewCardsPerDay int32  `json:"review_cards_per_day"`
+}
+
+type UpdateDeckRequest struct {
+	Name              string `json:"name"`
+	Description       string `json:"description"`
+	SourceLanguage    string `json:"source_language"`
+	TargetLanguage    string `json:"target_language"`
+	NewCardsPerDay    *int32 `json:"new_cards_per_day"`
+	ReviewCardsPerDay *int32 `json:"review_cards_per_day"`
+}
+
+type DeckVocabRequest struct {
+	VocabularyIDs []uint32 `json:"vocabulary_ids"`
+}
+
+type TransferVocabRequest struct {
+	TargetDeckID  uint32   `json:"target_deck_id"`
+	VocabularyIDs []uint32 `json:"vocabulary_ids,omitempty"`
+}
+
+// Response types
+type DeckResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+	Deck    *Deck  `json:"deck,omitempty"`
+}
+
+type DeckListResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+	Decks   []Deck `json:"decks"`
+}
+
+type DeckVocabResponse struct {
+	Success  bool   `json:"success"`
+	Message  string `json:"message"`
+	Affected int32  `json:"affected"`
+}
+
+type DeckStatsResponse struct {
+	Success      bool             `json:"success"`
+	Message      string           `json:"message"`
+	Deck         *Deck            `json:"deck,omitempty"`
+	TotalWords   int32            `json:"total_words"`
+	StatusCounts map[string]int32 `json:"status_counts"`
+	DueCount     int32            `json:"due_count"`
+	NewCount     int32            `json:"new_count"`
+	TotalReviews int32            `json:"total_reviews"`
+	AccuracyRate float64          `json:"accuracy_rate"`
+}
+
+type Deck struct {
+	ID                uint32 `json:"id"`
+	UserID            uint32 `json:"user_id"`
+	Name              string `json:"name"`
+	Description       string `json:"description"`
+	SourceLanguage    string `json:"source_language"`
+	TargetLanguage    string `json:"target_language"`
+	NewCardsPerDay    int32  `json:"new_cards_per_day"`
+	ReviewCardsPerDay int32  `json:"review_cards_per_day"`
+	VocabularyCount   int32  `json:"vocabulary_count"`
+	CreatedAt         string `json:"created_at"`
+	UpdatedAt         string `json:"updated_at"`
+}
+
+func NewDeckHandler(cfg *config.Config) *DeckHandler {
+	return &DeckHandler{cfg: cfg}
+}
+
+// ListDecks handles GET /decks
+func (d *DeckHandler) ListDecks(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.GetDecksRequest{
+		UserId: user.UserID,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := d.cfg.VocabServiceClient.GetDecks(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to get decks", http.StatusInternalServerError)
+		return
+	}
+
+	// Convert response
+	decks := make([]Deck, len(resp.Decks))
+	for i, deck := range resp.Decks {
+		decks[i] = *toDeck(deck)
+	}
+
+	response := DeckListResponse{
+		Success: resp.Success,
+		Message: resp.Message,
+		Decks:   decks,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+// CreateDeck handles POST /decks
+func (d *DeckHandler) CreateDeck(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse request body
+	var req CreateDeckRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		middleware.WriteErrorResponse(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Validate required fields
+	if req.Name == "" {
+		middleware.WriteErrorResponse(w, "Name is required", http.StatusBadRequest)
+		return
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.CreateDeckRequest{
+		UserId:            user.UserID,
+		Name:              req.Name,
+		Description:       req.Description,
+		SourceLanguage:    req.SourceLanguage,
+		TargetLanguage:    req.TargetLanguage,
+		NewCardsPerDay:    req.NewCardsPerDay,
+		ReviewCardsPerDay: req.ReviewCardsPerDay,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := d.cfg.VocabServiceClient.CreateDeck(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to create deck", http.StatusInternalServerError)
+		return
+	}
+
+	writeDeckResponse(w, resp)
+}
+
+// GetDeck handles GET /decks/{id}
+func (d *DeckHandler) GetDeck(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Extract deck ID from URL path
+	deckID, err := strconv.ParseUint(r.PathValue("id"), 10, 32)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Invalid deck ID", http.StatusBadRequest)
+		return
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.DeckRequest{
+		DeckId: uint32(deckID),
+		UserId: user.UserID,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := d.cfg.VocabServiceClient.GetDeckById(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to get deck", http.StatusInternalServerError)
+		return
+	}
+
+	writeDeckResponse(w, resp)
+}
+
+// UpdateDeck handles PUT /decks/{id}
+func (d *DeckHandler) UpdateDeck(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Extract deck ID from URL path
+	deckID, err := strconv.ParseUint(r.PathValue("id"), 10, 32)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Invalid deck ID", http.StatusBadRequest)
+		return
+	}
+
+	// Parse request body
+	var req UpdateDeckRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		middleware.WriteErrorResponse(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.UpdateDeckRequest{
+		DeckId:            uint32(deckID),
+		UserId:            user.UserID,
+		Name:              req.Name,
+		Description:       req.Description,
+		SourceLanguage:    req.SourceLanguage,
+		TargetLanguage:    req.TargetLanguage,
+		NewCardsPerDay:    req.NewCardsPerDay,
+		ReviewCardsPerDay: req.ReviewCardsPerDay,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := d.cfg.VocabServiceClient.UpdateDeck(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to update deck", http.StatusInternalServerError)
+		return
+	}
+
+	writeDeckResponse(w, resp)
+}
+
+// DeleteDeck handles DELETE /decks/{id}
+func (d *DeckHandler) DeleteDeck(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Extract deck ID from URL path
+	deckID, err := strconv.ParseUint(r.PathValue("id"), 10, 32)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Invalid deck ID", http.StatusBadRequest)
+		return
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.DeckRequest{
+		DeckId: uint32(deckID),
+		UserId: user.UserID,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := d.cfg.VocabServiceClient.DeleteDeck(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to delete deck", http.StatusInternalServerError)
+		return
+	}
+
+	response := map[string]interface{}{
+		"success": resp.Success,
+		"message": resp.Message,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+// GetDeckVocabularies handles GET /decks/{id}/vocab
+func (d *DeckHandler) GetDeckVocabularies(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Extract deck ID from URL path
+	deckID, err := strconv.ParseUint(r.PathValue("id"), 10, 32)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Invalid deck ID", http.StatusBadRequest)
+		return
+	}
+
+	// Parse query parameters; the same filters as GET /vocab apply
+	grpcReq := parseVocabListQuery(r, user.UserID)
+	grpcReq.DeckId = uint32(deckID)
+
+	listVocabularies(w, r, d.cfg, grpcReq)
+}
+
+// AddDeckVocabularies handles POST /decks/{id}/vocab
+func (d *DeckHandler) AddDeckVocabularies(w http.ResponseWriter, r *http.Request) {
+	d.changeDeckVocabularies(w, r, false)
+}
+
+// RemoveDeckVocabularies handles DELETE /decks/{id}/vocab
+func (d *DeckHandler) RemoveDeckVocabularies(w http.ResponseWriter, r *http.Request) {
+	d.changeDeckVocabularies(w, r, true)
+}
+
+// changeDeckVocabularies adds entries to a deck, or removes them if remove is set
+func (d *DeckHandler) changeDeckVocabularies(w http.ResponseWriter, r *http.Request, remove bool) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Extract deck ID from URL path
+	deckID, err := strconv.ParseUint(r.PathValue("id"), 10, 32)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Invalid deck ID", http.StatusBadRequest)
+		return
+	}
+
+	// Parse request body
+	var req DeckVocabRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		middleware.WriteErrorResponse(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Validate required fields
+	if len(req.VocabularyIDs) == 0 {
+		middleware.WriteErrorResponse(w, "Vocabulary IDs are required", http.StatusBadRequest)
+		return
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.DeckVocabulariesRequest{
+		DeckId:        uint32(deckID),
+		UserId:        user.UserID,
+		VocabularyIds: req.VocabularyIDs,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	var resp *pb.DeckVocabulariesResponse
+	if remove {
+		resp, err = d.cfg.VocabServiceClient.RemoveVocabulariesFromDeck(ctx, grpcReq)
+	} else {
+		resp, err = d.cfg.VocabServiceClient.AddVocabulariesToDeck(ctx, grpcReq)
+	}
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to update deck vocabularies", http.StatusInternalServerError)
+		return
+	}
+
+	writeDeckVocabResponse(w, resp)
+}
+
+// MoveDeckVocabularies handles POST /decks/{id}/vocab/move
+func (d *DeckHandler) MoveDeckVocabularies(w http.ResponseWriter, r *http.Request) {
+	d.transferDeckVocabularies(w, r, true)
+}
+
+// CopyDeckVocabularies handles POST /decks/{id}/vocab/copy
+func (d *DeckHandler) CopyDeckVocabularies(w http.ResponseWriter, r *http.Request) {
+	d.transferDeckVocabularies(w, r, false)
+}
+
+// transferDeckVocabularies copies entries to another deck, or moves them if move is set
+func (d *DeckHandler) transferDeckVocabularies(w http.ResponseWriter, r *http.Request, move bool) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Extract source deck ID from URL path
+	deckID, err := strconv.ParseUint(r.PathValue("id"), 10, 32)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Invalid deck ID", http.StatusBadRequest)
+		return
+	}
+
+	// Parse request body
+	var req TransferVocabRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		middleware.WriteErrorResponse(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Validate required fields
+	if req.TargetDeckID == 0 {
+		middleware.WriteErrorResponse(w, "Target deck ID is required", http.StatusBadRequest)
+		return
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.TransferVocabulariesRequest{
+		UserId:        user.UserID,
+		SourceDeckId:  uint32(deckID),
+		TargetDeckId:  req.TargetDeckID,
+		VocabularyIds: req.VocabularyIDs,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	var resp *pb.DeckVocabulariesResponse
+	if move {
+		resp, err = d.cfg.VocabServiceClient.MoveVocabularies(ctx, grpcReq)
+	} else {
+		resp, err = d.cfg.VocabServiceClient.CopyVocabularies(ctx, grpcReq)
+	}
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to transfer vocabularies", http.StatusInternalServerError)
+		return
+	}
+
+	writeDeckVocabResponse(w, resp)
+}
+
+// GetDeckStats handles GET /decks/{id}/stats
+func (d *DeckHandler) GetDeckStats(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Extract deck ID from URL path
+	deckID, err := strconv.ParseUint(r.PathValue("id"), 10, 32)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Invalid deck ID", http.StatusBadRequest)
+		return
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.DeckRequest{
+		DeckId: uint32(deckID),
+		UserId: user.UserID,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := d.cfg.VocabServiceClient.GetDeckStats(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to get deck statistics", http.StatusInternalServerError)
+		return
+	}
+
+	response := DeckStatsResponse{
+		Success:      resp.Success,
+		Message:      resp.Message,
+		Deck:         toDeck(resp.Deck),
+		TotalWords:   resp.TotalWords,
+		StatusCounts: resp.StatusCounts,
+		DueCount:     resp.DueCount,
+		NewCount:     resp.NewCount,
+		TotalReviews: resp.TotalReviews,
+		AccuracyRate: resp.AccuracyRate,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+// writeDeckResponse writes a single-deck gRPC response as JSON
+func writeDeckResponse(w http.ResponseWriter, resp *pb.DeckResponse) {
+	response := DeckResponse{
+		Success: resp.Success,
+		Message: resp.Message,
+		Deck:    toDeck(resp.Deck),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+// writeDeckVocabResponse writes a deck membership gRPC response as JSON
+func writeDeckVocabResponse(w http.ResponseWriter, resp *pb.DeckVocabulariesResponse) {
+	response := DeckVocabResponse{
+		Success:  resp.Success,
+		Message:  resp.Message,
+		Affected: resp.Affected,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+// toDeck converts a gRPC deck to its JSON representation
+func toDeck(deck *pb.Deck) *Deck {
+	if deck == nil {
+		return nil
+	}
+	return &Deck{
+		ID:                deck.Id,
+		UserID:            deck.UserId,
+		Name:              deck.Name,
+		Description:       deck.Description,
+		SourceLanguage:    deck.SourceLanguage,
+		TargetLanguage:    deck.TargetLanguage,
+		NewCardsPerDay:    deck.NewCardsPerDay,
+		ReviewCardsPerDay: deck.ReviewCardsPerDay,
+		VocabularyCount:   deck.VocabularyCount,
+		CreatedAt:         deck.CreatedAt,
+		UpdatedAt:         deck.UpdatedAt,
+	}
+}
@@ -0,0 +1,138 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/vocal-tracker/broker-service/middleware"
+	pb "github.com/vocal-tracker/broker-service/proto"
+)
+
+// Request types
+type MergeVocabRequest struct {
+	TargetID  uint32   `json:"target_id"`
+	SourceIDs []uint32 `json:"source_ids"`
+}
+
+// Response types
+type DuplicateGroupsResponse struct {
+	Success bool             `json:"success"`
+	Message string           `json:"message"`
+	Groups  []DuplicateGroup `json:"groups"`
+}
+
+type DuplicateGroup struct {
+	NormalizedWord string       `json:"normalized_word"`
+	Vocabularies   []Vocabulary `json:"vocabularies"`
+}
+
+// FindDuplicates handles GET /vocab/duplicates
+func (v *VocabHandler) FindDuplicates(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.FindDuplicatesRequest{
+		UserId: user.UserID,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := v.cfg.VocabServiceClient.FindDuplicates(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to find duplicates", http.StatusInternalServerError)
+		return
+	}
+
+	// Convert response
+	groups := make([]DuplicateGroup, len(resp.Groups))
+	for i, group := range resp.Groups {
+		vocabs := make([]Vocabulary, len(group.Vocabularies))
+		for j, vocab := range group.Vocabularies {
+			vocabs[j] = *toVocabulary(vocab)
+		}
+		groups[i] = DuplicateGroup{
+			NormalizedWord: group.NormalizedWord,
+			Vocabularies:   vocabs,
+		}
+	}
+
+	response := DuplicateGroupsResponse{
+		Success: resp.Success,
+		Message: resp.Message,
+		Groups:  groups,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+// MergeVocabularies handles POST /vocab/merge
+func (v *VocabHandler) MergeVocabularies(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse request body
+	var req MergeVocabRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		middleware.WriteErrorResponse(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Validate required fields
+	if req.TargetID == 0 || len(req.SourceIDs) == 0 {
+		middleware.WriteErrorResponse(w, "target_id and source_ids are required", http.StatusBadRequest)
+		return
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.MergeVocabulariesRequest{
+		UserId:    user.UserID,
+		TargetId:  req.TargetID,
+		SourceIds: req.SourceIDs,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := v.cfg.VocabServiceClient.MergeVocabularies(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to merge vocabularies", http.StatusInternalServerError)
+		return
+	}
+
+	response := VocabResponse{
+		Success: resp.Success,
+		Message: resp.Message,
+		Vocab:   toVocabulary(resp.Vocabulary),
+		Merged:  resp.Merged,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
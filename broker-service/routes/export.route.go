@@ -0,0 +1,96 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/vocal-tracker/broker-service/middleware"
+	pb "github.com/vocal-tracker/broker-service/proto"
+)
+
+// exportContentTypes maps each export format to its content type and file extension
+var exportContentTypes = map[string][2]string{
+	"csv":  {"text/csv; charset=utf-8", "csv"},
+	"tsv":  {"text/tab-separated-values; charset=utf-8", "tsv"},
+	"anki": {"text/plain; charset=utf-8", "txt"},
+	"json": {"application/json", "json"},
+}
+
+// ExportVocabularies handles GET /vocab/export
+func (v *VocabHandler) ExportVocabularies(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = "csv"
+	}
+	contentType, ok := exportContentTypes[format]
+	if !ok {
+		middleware.WriteErrorResponse(w, "Invalid format. Use csv, tsv, anki or json", http.StatusBadRequest)
+		return
+	}
+
+	// Same filters as GET /vocab; limit and offset do not apply
+	filter := parseVocabListQuery(r, user.UserID)
+
+	// Create gRPC request
+	grpcReq := &pb.ExportVocabulariesRequest{
+		UserId: user.UserID,
+		Format: format,
+		Filter: filter,
+	}
+
+	// Large collections take longer than a regular request
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	stream, err := v.cfg.VocabServiceClient.ExportVocabularies(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to export vocabularies", http.StatusInternalServerError)
+		return
+	}
+
+	// The first message says whether the export could start
+	resp, err := stream.Recv()
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to export vocabularies", http.StatusInternalServerError)
+		return
+	}
+	if !resp.Success {
+		middleware.WriteErrorResponse(w, resp.Message, http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType[0])
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="vocabulary.%s"`, contentType[1]))
+	flusher, _ := w.(http.Flusher)
+	for {
+		if _, err := w.Write(resp.Chunk); err != nil {
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+
+		resp, err = stream.Recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			// The headers are already sent, so the client sees a truncated file;
+			// abort the response so it is not mistaken for a complete one
+			panic(http.ErrAbortHandler)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/vocal-tracker/broker-service/middleware"
+	pb "github.com/vocal-tracker/broker-service/proto"
+)
+
+const (
+	// maxImportSize is the largest import file accepted
+	maxImportSize = 10 << 20
+	// importChunkSize is the size of the file chunks streamed to the vocabulary service
+	importChunkSize = 32 << 10
+)
+
+// Response types
+type ImportResponse struct {
+	Success    bool              `json:"success"`
+	Message    string            `json:"message"`
+	Created    int32             `json:"created"`
+	Duplicates int32             `json:"duplicates"`
+	Merged     int32             `json:"merged"`
+	Failed     int32             `json:"failed"`
+	Rows       []ImportRowResult `json:"rows"`
+}
+
+type ImportRowResult struct {
+	Row          int32  `json:"row"`
+	Word         string `json:"word"`
+	Result       string `json:"result"`
+	Message      string `json:"message,omitempty"`
+	VocabularyID uint32 `json:"vocabulary_id,omitempty"`
+}
+
+// ImportVocabularies handles POST /vocab/import
+func (v *VocabHandler) ImportVocabularies(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse multipart form
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportSize)
+	if err := r.ParseMultipartForm(maxImportSize); err != nil {
+		middleware.WriteErrorResponse(w, "Invalid form data or file larger than 10MB", http.StatusBadRequest)
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		middleware.WriteErrorResponse(w, "File is required", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	// Without an explicit format, .tsv files are read as TSV
+	format := r.FormValue("format")
+	if format == "" && strings.EqualFold(filepath.Ext(header.Filename), ".tsv") {
+		format = "tsv"
+	}
+
+	// Large files take longer than a regular request
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	stream, err := v.cfg.VocabServiceClient.ImportVocabularies(ctx)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to import vocabularies", http.StatusInternalServerError)
+		return
+	}
+
+	// Stream the file in chunks; the settings go with the first one
+	buf := make([]byte, importChunkSize)
+	first := true
+	for {
+		n, readErr := io.ReadFull(file, buf)
+		if n > 0 || first {
+			grpcReq := &pb.ImportVocabulariesRequest{Chunk: buf[:n]}
+			if first {
+				grpcReq.UserId = user.UserID
+				grpcReq.Format = format
+				grpcReq.OnDuplicate = r.FormValue("on_duplicate")
+				first = false
+			}
+			if err := stream.Send(grpcReq); err != nil {
+				// io.EOF means the service stopped reading; its response says why
+				if err == io.EOF {
+					break
+				}
+				middleware.WriteErrorResponse(w, "Failed to import vocabularies", http.StatusInternalServerError)
+				return
+			}
+		}
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			break
+		}
+		if readErr != nil {
+			middleware.WriteErrorResponse(w, "Failed to read file", http.StatusBadRequest)
+			return
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to import vocabularies", http.StatusInternalServerError)
+		return
+	}
+
+	// Convert response
+	rows := make([]ImportRowResult, len(resp.Rows))
+	for i, row := range resp.Rows {
+		rows[i] = ImportRowResult{
+			Row:          row.Row,
+			Word:         row.Word,
+			Result:       row.Result,
+			Message:      row.Message,
+			VocabularyID: row.VocabularyId,
+		}
+	}
+
+	response := ImportResponse{
+		Success:    resp.Success,
+		Message:    resp.Message,
+		Created:    resp.Created,
+		Duplicates: resp.Duplicates,
+		Merged:     resp.Merged,
+		Failed:     resp.Failed,
+		Rows:       rows,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,220 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/vocal-tracker/broker-service/config"
+	"github.com/vocal-tracker/broker-service/middleware"
+	pb "github.com/vocal-tracker/broker-service/proto"
+)
+
+type QuizHandler struct {
+	cfg *config.Config
+}
+
+// Request types
+type GenerateQuizRequest struct {
+	QuestionCount int32  `json:"question_count"`
+	Direction     string `json:"direction"`
+	Status        string `json:"status"`
+	DateFrom      string `json:"date_from"`
+	DateTo        string `json:"date_to"`
+	ChoiceCount   int32  `json:"choice_count"`
+}
+
+type SubmitQuizRequest struct {
+	// QuizID is the quiz returned by POST /quiz
+	QuizID    uint32       `json:"quiz_id"`
+	Direction string       `json:"direction,omitempty"`
+	Answers   []QuizAnswer `json:"answers"`
+}
+
+type QuizAnswer struct {
+	VocabularyID   uint32 `json:"vocabulary_id"`
+	Answer         string `json:"answer"`
+	ResponseTimeMs int32  `json:"response_time_ms,omitempty"`
+}
+
+// Response types
+type QuizResponse struct {
+	Success   bool           `json:"success"`
+	Message   string         `json:"message"`
+	Direction string         `json:"direction,omitempty"`
+	Questions []QuizQuestion `json:"questions"`
+	QuizID    uint32         `json:"quiz_id,omitempty"`
+}
+
+type QuizQuestion struct {
+	VocabularyID uint32   `json:"vocabulary_id"`
+	Prompt       string   `json:"prompt"`
+	Options      []string `json:"options"`
+}
+
+type QuizResultResponse struct {
+	Success bool         `json:"success"`
+	Message string       `json:"message"`
+	Correct int32        `json:"correct"`
+	Total   int32        `json:"total"`
+	Score   float64      `json:"score"`
+	Results []QuizResult `json:"results"`
+}
+
+type QuizResult struct {
+	VocabularyID  uint32      `json:"vocabulary_id"`
+	Correct       bool        `json:"correct"`
+	Answer        string      `json:"answer"`
+	CorrectAnswer string      `json:"correct_answer"`
+	Vocab         *Vocabulary `json:"vocabulary,omitempty"`
+}
+
+func NewQuizHandler(cfg *config.Config) *QuizHandler {
+	return &QuizHandler{cfg: cfg}
+}
+
+// GenerateQuiz handles POST /quiz
+func (q *QuizHandler) GenerateQuiz(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse request body
+	var req GenerateQuizRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		middleware.WriteErrorResponse(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.GenerateQuizRequest{
+		UserId:        user.UserID,
+		QuestionCount: req.QuestionCount,
+		Direction:     req.Direction,
+		Status:        req.Status,
+		DateFrom:      req.DateFrom,
+		DateTo:        req.DateTo,
+		ChoiceCount:   req.ChoiceCount,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := q.cfg.VocabServiceClient.GenerateQuiz(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to generate quiz", http.StatusInternalServerError)
+		return
+	}
+
+	// Convert response
+	questions := make([]QuizQuestion, len(resp.Questions))
+	for i, question := range resp.Questions {
+		questions[i] = QuizQuestion{
+			VocabularyID: question.VocabularyId,
+			Prompt:       question.Prompt,
+			Options:      question.Options,
+		}
+	}
+
+	response := QuizResponse{
+		Success:   resp.Success,
+		Message:   resp.Message,
+		Direction: resp.Direction,
+		Questions: questions,
+		QuizID:    resp.QuizId,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+// SubmitQuiz handles POST /quiz/submit
+func (q *QuizHandler) SubmitQuiz(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse request body
+	var req SubmitQuizRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		middleware.WriteErrorResponse(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Validate required fields
+	if len(req.Answers) == 0 {
+		middleware.WriteErrorResponse(w, "Answers are required", http.StatusBadRequest)
+		return
+	}
+
+	// Create gRPC request
+	answers := make([]*pb.QuizAnswer, len(req.Answers))
+	for i, answer := range req.Answers {
+		answers[i] = &pb.QuizAnswer{
+			VocabularyId:   answer.VocabularyID,
+			Answer:         answer.Answer,
+			ResponseTimeMs: answer.ResponseTimeMs,
+		}
+	}
+
+	grpcReq := &pb.SubmitQuizRequest{
+		UserId:    user.UserID,
+		QuizId:    req.QuizID,
+		Direction: req.Direction,
+		Answers:   answers,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := q.cfg.VocabServiceClient.SubmitQuiz(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to submit quiz", http.StatusInternalServerError)
+		return
+	}
+
+	// Convert response
+	results := make([]QuizResult, len(resp.Results))
+	for i, result := range resp.Results {
+		results[i] = QuizResult{
+			VocabularyID:  result.VocabularyId,
+			Correct:       result.Correct,
+			Answer:        result.Answer,
+			CorrectAnswer: result.CorrectAnswer,
+			Vocab:         toVocabulary(result.Vocabulary),
+		}
+	}
+
+	response := QuizResultResponse{
+		Success: resp.Success,
+		Message: resp.Message,
+		Correct: resp.Correct,
+		Total:   resp.Total,
+		Score:   resp.Score,
+		Results: results,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,238 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/vocal-tracker/broker-service/middleware"
+	pb "github.com/vocal-tracker/broker-service/proto"
+)
+
+type ReviewVocabRequest struct {
+	Grade          string `json:"grade"`
+	ResponseTimeMs int32  `json:"response_time_ms,omitempty"`
+}
+
+type ReviewAttempt struct {
+	ID             uint32 `json:"id"`
+	VocabularyID   uint32 `json:"vocabulary_id"`
+	Grade          string `json:"grade"`
+	ResponseTimeMs int32  `json:"response_time_ms"`
+	ReviewedAt     string `json:"reviewed_at"`
+}
+
+type ReviewHistoryResponse struct {
+	Success      bool            `json:"success"`
+	Message      string          `json:"message"`
+	Attempts     []ReviewAttempt `json:"attempts"`
+	Total        int32           `json:"total"`
+	AccuracyRate float64         `json:"accuracy_rate"`
+}
+
+type DueVocabListResponse struct {
+	Success      bool         `json:"success"`
+	Message      string       `json:"message"`
+	Vocabularies []Vocabulary `json:"vocabularies"`
+	ReviewCount  int32        `json:"review_count"`
+	NewCount     int32        `json:"new_count"`
+	TotalDue     int32        `json:"total_due"`
+}
+
+// ReviewVocabulary handles POST /vocab/{id}/review
+func (v *VocabHandler) ReviewVocabulary(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Extract vocab ID from URL path
+	vocabID, err := strconv.ParseUint(r.PathValue("id"), 10, 32)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Invalid vocabulary ID", http.StatusBadRequest)
+		return
+	}
+
+	// Parse request body
+	var req ReviewVocabRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		middleware.WriteErrorResponse(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Validate required fields
+	if req.Grade == "" {
+		middleware.WriteErrorResponse(w, "Grade is required", http.StatusBadRequest)
+		return
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.ReviewVocabularyRequest{
+		VocabularyId:   uint32(vocabID),
+		UserId:         user.UserID,
+		Grade:          req.Grade,
+		ResponseTimeMs: req.ResponseTimeMs,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := v.cfg.VocabServiceClient.ReviewVocabulary(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to review vocabulary", http.StatusInternalServerError)
+		return
+	}
+
+	response := VocabResponse{
+		Success: resp.Success,
+		Message: resp.Message,
+		Vocab:   toVocabulary(resp.Vocabulary),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+// GetDueVocabularies handles GET /vocab/due
+func (v *VocabHandler) GetDueVocabularies(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse query parameters (zero means the server default)
+	var newLimit, reviewLimit int32
+	if l, err := strconv.Atoi(r.URL.Query().Get("new_limit")); err == nil {
+		newLimit = int32(l)
+	}
+	if l, err := strconv.Atoi(r.URL.Query().Get("review_limit")); err == nil {
+		reviewLimit = int32(l)
+	}
+	var deckID uint32
+	if d, err := strconv.ParseUint(r.URL.Query().Get("deck_id"), 10, 32); err == nil {
+		deckID = uint32(d)
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.GetDueVocabulariesRequest{
+		UserId:      user.UserID,
+		NewLimit:    newLimit,
+		ReviewLimit: reviewLimit,
+		DeckId:      deckID,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := v.cfg.VocabServiceClient.GetDueVocabularies(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to get due vocabularies", http.StatusInternalServerError)
+		return
+	}
+
+	// Convert response
+	vocabularies := make([]Vocabulary, len(resp.Vocabularies))
+	for i, vocab := range resp.Vocabularies {
+		vocabularies[i] = *toVocabulary(vocab)
+	}
+
+	response := DueVocabListResponse{
+		Success:      resp.Success,
+		Message:      resp.Message,
+		Vocabularies: vocabularies,
+		ReviewCount:  resp.ReviewCount,
+		NewCount:     resp.NewCount,
+		TotalDue:     resp.TotalDue,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+// GetReviewHistory handles GET /vocab/{id}/reviews
+func (v *VocabHandler) GetReviewHistory(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Extract vocab ID from URL path
+	vocabID, err := strconv.ParseUint(r.PathValue("id"), 10, 32)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Invalid vocabulary ID", http.StatusBadRequest)
+		return
+	}
+
+	var limit int32
+	if l, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil {
+		limit = int32(l)
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.GetReviewHistoryRequest{
+		VocabularyId: uint32(vocabID),
+		UserId:       user.UserID,
+		Limit:        limit,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := v.cfg.VocabServiceClient.GetReviewHistory(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to get review history", http.StatusInternalServerError)
+		return
+	}
+
+	// Convert response
+	attempts := make([]ReviewAttempt, len(resp.Attempts))
+	for i, attempt := range resp.Attempts {
+		attempts[i] = ReviewAttempt{
+			ID:             attempt.Id,
+			VocabularyID:   attempt.VocabularyId,
+			Grade:          attempt.Grade,
+			ResponseTimeMs: attempt.ResponseTimeMs,
+			ReviewedAt:     attempt.ReviewedAt,
+		}
+	}
+
+	response := ReviewHistoryResponse{
+		Success:      resp.Success,
+		Message:      resp.Message,
+		Attempts:     attempts,
+		Total:        resp.Total,
+		AccuracyRate: resp.AccuracyRate,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,186 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/vocal-tracker/broker-service/middleware"
+	pb "github.com/vocal-tracker/broker-service/proto"
+)
+
+// Request types
+type RevertVocabRequest struct {
+	// RevisionID is the oldest revision to undo
+	RevisionID uint32 `json:"revision_id"`
+}
+
+// Response types
+type RevisionHistoryResponse struct {
+	Success   bool       `json:"success"`
+	Message   string     `json:"message"`
+	Revisions []Revision `json:"revisions"`
+	Total     int32      `json:"total"`
+}
+
+type Revision struct {
+	ID           uint32        `json:"id"`
+	VocabularyID uint32        `json:"vocabulary_id"`
+	UserID       uint32        `json:"user_id"`
+	Source       string        `json:"source"`
+	Changes      []FieldChange `json:"changes"`
+	CreatedAt    string        `json:"created_at"`
+}
+
+type FieldChange struct {
+	Field    string `json:"field"`
+	OldValue string `json:"old_value"`
+	NewValue string `json:"new_value"`
+}
+
+// GetVocabularyHistory handles GET /vocab/{id}/history
+func (v *VocabHandler) GetVocabularyHistory(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Extract vocab ID from URL path
+	vocabID, err := strconv.ParseUint(r.PathValue("id"), 10, 32)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Invalid vocabulary ID", http.StatusBadRequest)
+		return
+	}
+
+	// Parse query parameters
+	query := r.URL.Query()
+	var limit, offset int32
+	if l, err := strconv.Atoi(query.Get("limit")); err == nil {
+		limit = int32(l)
+	}
+	if o, err := strconv.Atoi(query.Get("offset")); err == nil {
+		offset = int32(o)
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.GetVocabularyRevisionsRequest{
+		UserId:       user.UserID,
+		VocabularyId: uint32(vocabID),
+		Limit:        limit,
+		Offset:       offset,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := v.cfg.VocabServiceClient.GetVocabularyRevisions(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to get vocabulary history", http.StatusInternalServerError)
+		return
+	}
+
+	// Convert response
+	revisions := make([]Revision, len(resp.Revisions))
+	for i, revision := range resp.Revisions {
+		changes := make([]FieldChange, len(revision.Changes))
+		for j, change := range revision.Changes {
+			changes[j] = FieldChange{
+				Field:    change.Field,
+				OldValue: change.OldValue,
+				NewValue: change.NewValue,
+			}
+		}
+		revisions[i] = Revision{
+			ID:           revision.Id,
+			VocabularyID: revision.VocabularyId,
+			UserID:       revision.UserId,
+			Source:       revision.Source,
+			Changes:      changes,
+			CreatedAt:    revision.CreatedAt,
+		}
+	}
+
+	response := RevisionHistoryResponse{
+		Success:   resp.Success,
+		Message:   resp.Message,
+		Revisions: revisions,
+		Total:     resp.Total,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+// RevertVocabulary handles POST /vocab/{id}/revert
+func (v *VocabHandler) RevertVocabulary(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Extract vocab ID from URL path
+	vocabID, err := strconv.ParseUint(r.PathValue("id"), 10, 32)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Invalid vocabulary ID", http.StatusBadRequest)
+		return
+	}
+
+	// Parse request body
+	var req RevertVocabRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		middleware.WriteErrorResponse(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if req.RevisionID == 0 {
+		middleware.WriteErrorResponse(w, "revision_id is required", http.StatusBadRequest)
+		return
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.RevertVocabularyRequest{
+		UserId:       user.UserID,
+		VocabularyId: uint32(vocabID),
+		RevisionId:   req.RevisionID,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := v.cfg.VocabServiceClient.RevertVocabulary(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to revert vocabulary", http.StatusInternalServerError)
+		return
+	}
+
+	response := VocabResponse{
+		Success:   resp.Success,
+		Message:   resp.Message,
+		Vocab:     toVocabulary(resp.Vocabulary),
+		Duplicate: resp.Duplicate,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Duplicate {
+		w.WriteHeader(http.StatusConflict)
+	} else if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
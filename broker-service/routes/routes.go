@@ -14,6 +14,12 @@ func NewRouter(cfg *config.Config) http.Handler {
 	// Create handlers
 	authHandler := NewAuthHandler(cfg)
 	vocabHandler := NewVocabHandler(cfg)
+	statsHandler := NewStatsHandler(cfg)
+	quizHandler := NewQuizHandler(cfg)
+	tagHandler := NewTagHandler(cfg)
+	deckHandler := NewDeckHandler(cfg)
+	accountHandler := NewAccountHandler(cfg)
+	stageHandler := NewStageHandler(cfg)
 	authMiddleware := middleware.NewAuthMiddleware(cfg)
 
 	// Register auth routes with /auth prefix to match frontend expectations
@@ -25,13 +31,97 @@ func NewRouter(cfg *config.Config) http.Handler {
 	// Register vocabulary routes with auth middleware
 	mux.Handle("GET /vocab", authMiddleware.RequireAuth(http.HandlerFunc(vocabHandler.GetVocabularies)))
 	mux.Handle("POST /vocab", authMiddleware.RequireAuth(http.HandlerFunc(vocabHandler.CreateVocabulary)))
+	mux.Handle("GET /vocab/{id}", authMiddleware.RequireAuth(http.HandlerFunc(vocabHandler.GetVocabulary)))
 	mux.Handle("PUT /vocab/", authMiddleware.RequireAuth(http.HandlerFunc(vocabUpdateHandler(vocabHandler))))
+	mux.Handle("PATCH /vocab/{id}", authMiddleware.RequireAuth(http.HandlerFunc(vocabHandler.PatchVocabulary)))
 	mux.Handle("DELETE /vocab/", authMiddleware.RequireAuth(http.HandlerFunc(vocabDeleteHandler(vocabHandler))))
+	mux.Handle("DELETE /vocab", authMiddleware.RequireAuth(http.HandlerFunc(vocabHandler.DeleteVocabularies)))
+	mux.Handle("POST /vocab/batch", authMiddleware.RequireAuth(http.HandlerFunc(vocabHandler.BatchVocabularies)))
+	mux.Handle("POST /vocab/bulk-update", authMiddleware.RequireAuth(http.HandlerFunc(vocabHandler.BulkUpdateVocabularies)))
+	mux.Handle("GET /vocab/trash", authMiddleware.RequireAuth(http.HandlerFunc(vocabHandler.ListTrash)))
+	mux.Handle("POST /vocab/trash/{id}/restore", authMiddleware.RequireAuth(http.HandlerFunc(vocabHandler.RestoreVocabulary)))
+	mux.Handle("DELETE /vocab/trash", authMiddleware.RequireAuth(http.HandlerFunc(vocabHandler.PurgeTrash)))
+	mux.Handle("DELETE /vocab/trash/{id}", authMiddleware.RequireAuth(http.HandlerFunc(vocabHandler.PurgeTrash)))
+	mux.Handle("GET /vocab/due", authMiddleware.RequireAuth(http.HandlerFunc(vocabHandler.GetDueVocabularies)))
+	mux.Handle("POST /vocab/{id}/review", authMiddleware.RequireAuth(http.HandlerFunc(vocabHandler.ReviewVocabulary)))
+	mux.Handle("GET /vocab/{id}/reviews", authMiddleware.RequireAuth(http.HandlerFunc(vocabHandler.GetReviewHistory)))
+	mux.Handle("GET /vocab/{id}/history", authMiddleware.RequireAuth(http.HandlerFunc(vocabHandler.GetVocabularyHistory)))
+	mux.Handle("POST /vocab/{id}/revert", authMiddleware.RequireAuth(http.HandlerFunc(vocabHandler.RevertVocabulary)))
+	mux.Handle("GET /vocab/{id}/transitions", authMiddleware.RequireAuth(http.HandlerFunc(vocabHandler.GetVocabularyTransitions)))
+	mux.Handle("GET /vocab/cloze", authMiddleware.RequireAuth(http.HandlerFunc(vocabHandler.GetClozeExercises)))
+	mux.Handle("POST /vocab/cloze/grade", authMiddleware.RequireAuth(http.HandlerFunc(vocabHandler.GradeCloze)))
+	mux.Handle("GET /vocab/duplicates", authMiddleware.RequireAuth(http.HandlerFunc(vocabHandler.FindDuplicates)))
+	mux.Handle("POST /vocab/merge", authMiddleware.RequireAuth(http.HandlerFunc(vocabHandler.MergeVocabularies)))
+	mux.Handle("POST /vocab/import", authMiddleware.RequireAuth(http.HandlerFunc(vocabHandler.ImportVocabularies)))
+	mux.Handle("GET /vocab/export", authMiddleware.RequireAuth(http.HandlerFunc(vocabHandler.ExportVocabularies)))
+	mux.Handle("GET /vocab/suggest", authMiddleware.RequireAuth(http.HandlerFunc(vocabHandler.SuggestWords)))
+	mux.Handle("GET /vocab/saved", authMiddleware.RequireAuth(http.HandlerFunc(vocabHandler.GetSavedSearches)))
+	mux.Handle("POST /vocab/saved", authMiddleware.RequireAuth(http.HandlerFunc(vocabHandler.CreateSavedSearch)))
+	mux.Handle("PUT /vocab/saved/{id}", authMiddleware.RequireAuth(http.HandlerFunc(vocabHandler.UpdateSavedSearch)))
+	mux.Handle("DELETE /vocab/saved/{id}", authMiddleware.RequireAuth(http.HandlerFunc(vocabHandler.DeleteSavedSearch)))
+	mux.Handle("GET /vocab/saved/{id}/results", authMiddleware.RequireAuth(http.HandlerFunc(vocabHandler.GetSavedSearchResults)))
 
 	// OPTIONS for vocab routes
 	mux.HandleFunc("OPTIONS /vocab", handleOptions)
 	mux.HandleFunc("OPTIONS /vocab/", handleOptions)
 
+	// Register quiz routes with auth middleware
+	mux.Handle("POST /quiz", authMiddleware.RequireAuth(http.HandlerFunc(quizHandler.GenerateQuiz)))
+	mux.Handle("POST /quiz/submit", authMiddleware.RequireAuth(http.HandlerFunc(quizHandler.SubmitQuiz)))
+
+	// OPTIONS for quiz routes
+	mux.HandleFunc("OPTIONS /quiz", handleOptions)
+	mux.HandleFunc("OPTIONS /quiz/", handleOptions)
+
+	// Register tag routes with auth middleware
+	mux.Handle("GET /tags", authMiddleware.RequireAuth(http.HandlerFunc(tagHandler.ListTags)))
+	mux.Handle("PUT /tags/{id}", authMiddleware.RequireAuth(http.HandlerFunc(tagHandler.RenameTag)))
+	mux.Handle("DELETE /tags/{id}", authMiddleware.RequireAuth(http.HandlerFunc(tagHandler.DeleteTag)))
+
+	// OPTIONS for tag routes
+	mux.HandleFunc("OPTIONS /tags", handleOptions)
+	mux.HandleFunc("OPTIONS /tags/", handleOptions)
+
+	// Register deck routes with auth middleware
+	mux.Handle("GET /decks", authMiddleware.RequireAuth(http.HandlerFunc(deckHandler.ListDecks)))
+	mux.Handle("POST /decks", authMiddleware.RequireAuth(http.HandlerFunc(deckHandler.CreateDeck)))
+	mux.Handle("GET /decks/{id}", authMiddleware.RequireAuth(http.HandlerFunc(deckHandler.GetDeck)))
+	mux.Handle("PUT /decks/{id}", authMiddleware.RequireAuth(http.HandlerFunc(deckHandler.UpdateDeck)))
+	mux.Handle("DELETE /decks/{id}", authMiddleware.RequireAuth(http.HandlerFunc(deckHandler.DeleteDeck)))
+	mux.Handle("GET /decks/{id}/vocab", authMiddleware.RequireAuth(http.HandlerFunc(deckHandler.GetDeckVocabularies)))
+	mux.Handle("POST /decks/{id}/vocab", authMiddleware.RequireAuth(http.HandlerFunc(deckHandler.AddDeckVocabularies)))
+	mux.Handle("DELETE /decks/{id}/vocab", authMiddleware.RequireAuth(http.HandlerFunc(deckHandler.RemoveDeckVocabularies)))
+	mux.Handle("POST /decks/{id}/vocab/move", authMiddleware.RequireAuth(http.HandlerFunc(deckHandler.MoveDeckVocabularies)))
+	mux.Handle("POST /decks/{id}/vocab/copy", authMiddleware.RequireAuth(http.HandlerFunc(deckHandler.CopyDeckVocabularies)))
+	mux.Handle("GET /decks/{id}/stats", authMiddleware.RequireAuth(http.HandlerFunc(deckHandler.GetDeckStats)))
+
+	// OPTIONS for deck routes
+	mux.HandleFunc("OPTIONS /decks", handleOptions)
+	mux.HandleFunc("OPTIONS /decks/", handleOptions)
+
+	// Register statistics routes with auth middleware
+	mux.Handle("GET /stats", authMiddleware.RequireAuth(http.HandlerFunc(statsHandler.GetVocabularyStats)))
+	mux.Handle("GET /stats/progress", authMiddleware.RequireAuth(http.HandlerFunc(statsHandler.GetProgressTimeline)))
+	mux.Handle("PUT /stats/goal", authMiddleware.RequireAuth(http.HandlerFunc(statsHandler.SetDailyGoal)))
+
+	// OPTIONS for stats routes
+	mux.HandleFunc("OPTIONS /stats", handleOptions)
+	mux.HandleFunc("OPTIONS /stats/", handleOptions)
+
+	// Register stage routes with auth middleware
+	mux.Handle("GET /stages", authMiddleware.RequireAuth(http.HandlerFunc(stageHandler.GetStages)))
+	mux.Handle("PUT /stages", authMiddleware.RequireAuth(http.HandlerFunc(stageHandler.SetStages)))
+
+	// OPTIONS for stage routes
+	mux.HandleFunc("OPTIONS /stages", handleOptions)
+
+	// Register account routes with auth middleware
+	mux.Handle("GET /account/backup", authMiddleware.RequireAuth(http.HandlerFunc(accountHandler.BackupAccount)))
+	mux.Handle("POST /account/restore", authMiddleware.RequireAuth(http.HandlerFunc(accountHandler.RestoreAccount)))
+
+	// OPTIONS for account routes
+	mux.HandleFunc("OPTIONS /account/", handleOptions)
+
 	return mux
 }
 
@@ -62,8 +152,9 @@ func enableCORS(next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// Set CORS headers
 		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Requested-With")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Requested-With, If-Match, If-None-Match")
+		w.Header().Set("Access-Control-Expose-Headers", "ETag")
 		w.Header().Set("Access-Control-Allow-Credentials", "true")
 
 		// Handle preflight OPTIONS request
@@ -79,8 +170,9 @@ func enableCORS(next http.HandlerFunc) http.HandlerFunc {
 // handleOptions handles preflight OPTIONS requests
 func handleOptions(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Access-Control-Allow-Origin", "*")
-	w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-	w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Requested-With")
+	w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+	w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Requested-With, If-Match, If-None-Match")
+	w.Header().Set("Access-Control-Expose-Headers", "ETag")
 	w.Header().Set("Access-Control-Allow-Credentials", "true")
 	w.WriteHeader(http.StatusOK)
 }
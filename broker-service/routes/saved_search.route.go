@@ -0,0 +1,341 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/vocal-tracker/broker-service/middleware"
+	pb "github.com/vocal-tracker/broker-service/proto"
+)
+
+// Request types
+type SavedSearchRequest struct {
+	Name string `json:"name"`
+	// Filter replaces every saved filter when present; leave it out on update to keep them
+	Filter *SavedSearchFilter `json:"filter"`
+}
+
+// SavedSearchFilter holds the same filters as the GET /vocab query parameters
+type SavedSearchFilter struct {
+	Date     string   `json:"date"`
+	Search   string   `json:"search"`
+	Q        string   `json:"q"`
+	Status   string   `json:"status"`
+	Tags     []string `json:"tags"`
+	TagMatch string   `json:"tag_match"`
+	DeckID   uint32   `json:"deck_id"`
+}
+
+// Response types
+type SavedSearchResponse struct {
+	Success     bool         `json:"success"`
+	Message     string       `json:"message"`
+	SavedSearch *SavedSearch `json:"saved_search,omitempty"`
+}
+
+type SavedSearchListResponse struct {
+	Success       bool          `json:"success"`
+	Message       string        `json:"message"`
+	SavedSearches []SavedSearch `json:"saved_searches"`
+}
+
+type SavedSearch struct {
+	ID        uint32            `json:"id"`
+	UserID    uint32            `json:"user_id"`
+	Name      string            `json:"name"`
+	Filter    SavedSearchFilter `json:"filter"`
+	CreatedAt string            `json:"created_at"`
+	UpdatedAt string            `json:"updated_at"`
+}
+
+// GetSavedSearches handles GET /vocab/saved
+func (v *VocabHandler) GetSavedSearches(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.GetSavedSearchesRequest{
+		UserId: user.UserID,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := v.cfg.VocabServiceClient.GetSavedSearches(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to get saved searches", http.StatusInternalServerError)
+		return
+	}
+
+	// Convert response
+	searches := make([]SavedSearch, len(resp.SavedSearches))
+	for i, search := range resp.SavedSearches {
+		searches[i] = *toSavedSearch(search)
+	}
+
+	response := SavedSearchListResponse{
+		Success:       resp.Success,
+		Message:       resp.Message,
+		SavedSearches: searches,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+// CreateSavedSearch handles POST /vocab/saved
+func (v *VocabHandler) CreateSavedSearch(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse request body
+	var req SavedSearchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		middleware.WriteErrorResponse(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if req.Name == "" {
+		middleware.WriteErrorResponse(w, "Name is required", http.StatusBadRequest)
+		return
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.CreateSavedSearchRequest{
+		UserId: user.UserID,
+		Name:   req.Name,
+		Filter: toSavedSearchFilterProto(req.Filter),
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := v.cfg.VocabServiceClient.CreateSavedSearch(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to create saved search", http.StatusInternalServerError)
+		return
+	}
+
+	writeSavedSearchResponse(w, resp)
+}
+
+// UpdateSavedSearch handles PUT /vocab/saved/{id}
+func (v *VocabHandler) UpdateSavedSearch(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Extract saved search ID from URL path
+	searchID, err := strconv.ParseUint(r.PathValue("id"), 10, 32)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Invalid saved search ID", http.StatusBadRequest)
+		return
+	}
+
+	// Parse request body
+	var req SavedSearchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		middleware.WriteErrorResponse(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.UpdateSavedSearchRequest{
+		UserId:        user.UserID,
+		SavedSearchId: uint32(searchID),
+		Name:          req.Name,
+	}
+	if req.Filter != nil {
+		grpcReq.Filter = toSavedSearchFilterProto(req.Filter)
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := v.cfg.VocabServiceClient.UpdateSavedSearch(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to update saved search", http.StatusInternalServerError)
+		return
+	}
+
+	writeSavedSearchResponse(w, resp)
+}
+
+// DeleteSavedSearch handles DELETE /vocab/saved/{id}
+func (v *VocabHandler) DeleteSavedSearch(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Extract saved search ID from URL path
+	searchID, err := strconv.ParseUint(r.PathValue("id"), 10, 32)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Invalid saved search ID", http.StatusBadRequest)
+		return
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.SavedSearchRequest{
+		UserId:        user.UserID,
+		SavedSearchId: uint32(searchID),
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := v.cfg.VocabServiceClient.DeleteSavedSearch(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to delete saved search", http.StatusInternalServerError)
+		return
+	}
+
+	response := map[string]interface{}{
+		"success": resp.Success,
+		"message": resp.Message,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+// GetSavedSearchResults handles GET /vocab/saved/{id}/results
+func (v *VocabHandler) GetSavedSearchResults(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Extract saved search ID from URL path
+	searchID, err := strconv.ParseUint(r.PathValue("id"), 10, 32)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Invalid saved search ID", http.StatusBadRequest)
+		return
+	}
+
+	// Only paging and sorting apply; the filters come from the saved search
+	page := parseVocabListQuery(r, user.UserID)
+
+	// Create gRPC request
+	grpcReq := &pb.GetSavedSearchResultsRequest{
+		UserId:        user.UserID,
+		SavedSearchId: uint32(searchID),
+		Limit:         page.Limit,
+		Offset:        page.Offset,
+		SortBy:        page.SortBy,
+		SortOrder:     page.SortOrder,
+		PageToken:     page.PageToken,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := v.cfg.VocabServiceClient.GetSavedSearchResults(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to get saved search results", http.StatusInternalServerError)
+		return
+	}
+
+	writeVocabListResponse(w, r, resp)
+}
+
+// writeSavedSearchResponse writes a single saved search gRPC response as JSON
+func writeSavedSearchResponse(w http.ResponseWriter, resp *pb.SavedSearchResponse) {
+	response := SavedSearchResponse{
+		Success: resp.Success,
+		Message: resp.Message,
+	}
+	if resp.SavedSearch != nil {
+		response.SavedSearch = toSavedSearch(resp.SavedSearch)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+// toSavedSearch converts a gRPC saved search to its JSON representation
+func toSavedSearch(search *pb.SavedSearch) *SavedSearch {
+	filter := SavedSearchFilter{Tags: []string{}}
+	if search.Filter != nil {
+		filter = SavedSearchFilter{
+			Date:     search.Filter.Date,
+			Search:   search.Filter.Search,
+			Q:        search.Filter.Q,
+			Status:   search.Filter.Status,
+			Tags:     append([]string{}, search.Filter.Tags...),
+			TagMatch: search.Filter.TagMatch,
+			DeckID:   search.Filter.DeckId,
+		}
+	}
+	return &SavedSearch{
+		ID:        search.Id,
+		UserID:    search.UserId,
+		Name:      search.Name,
+		Filter:    filter,
+		CreatedAt: search.CreatedAt,
+		UpdatedAt: search.UpdatedAt,
+	}
+}
+
+// toSavedSearchFilterProto converts saved search filters to a gRPC request;
+// nil gives no filters
+func toSavedSearchFilterProto(filter *SavedSearchFilter) *pb.GetVocabulariesRequest {
+	if filter == nil {
+		return &pb.GetVocabulariesRequest{}
+	}
+	return &pb.GetVocabulariesRequest{
+		Date:     filter.Date,
+		Search:   filter.Search,
+		Q:        filter.Q,
+		Status:   filter.Status,
+		Tags:     filter.Tags,
+		TagMatch: filter.TagMatch,
+		DeckId:   filter.DeckID,
+	}
+}
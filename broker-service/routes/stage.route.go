@@ -0,0 +1,264 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vocal-tracker/broker-service/config"
+	"github.com/vocal-tracker/broker-service/middleware"
+	pb "github.com/vocal-tracker/broker-service/proto"
+)
+
+type StageHandler struct {
+	cfg *config.Config
+}
+
+// Request types
+type SetStagesRequest struct {
+	// Stages replaces the user's stages, in learning order; an empty list
+	// restores the built-in stages
+	Stages []Stage `json:"stages"`
+}
+
+// Response types
+type StagesResponse struct {
+	Success bool    `json:"success"`
+	Message string  `json:"message"`
+	Stages  []Stage `json:"stages"`
+	Custom  bool    `json:"custom"`
+	Updated int32   `json:"updated"`
+}
+
+type Stage struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	// Next are the stages an entry can move to from this one. Defaults to
+	// the next stage and every earlier one.
+	Next []string `json:"next,omitempty"`
+}
+
+type TransitionListResponse struct {
+	Success     bool         `json:"success"`
+	Message     string       `json:"message"`
+	Transitions []Transition `json:"transitions"`
+	Total       int32        `json:"total"`
+}
+
+type Transition struct {
+	ID           uint32 `json:"id"`
+	VocabularyID uint32 `json:"vocabulary_id"`
+	FromStage    string `json:"from_stage"`
+	ToStage      string `json:"to_stage"`
+	FromStatus   string `json:"from_status"`
+	ToStatus     string `json:"to_status"`
+	Source       string `json:"source"`
+	CreatedAt    string `json:"created_at"`
+}
+
+func NewStageHandler(cfg *config.Config) *StageHandler {
+	return &StageHandler{cfg: cfg}
+}
+
+// GetStages handles GET /stages
+func (s *StageHandler) GetStages(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.GetStatusStagesRequest{
+		UserId: user.UserID,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := s.cfg.VocabServiceClient.GetStatusStages(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to get stages", http.StatusInternalServerError)
+		return
+	}
+
+	writeStagesResponse(w, resp)
+}
+
+// SetStages handles PUT /stages
+func (s *StageHandler) SetStages(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse request body
+	var req SetStagesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		middleware.WriteErrorResponse(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Create gRPC request. An unknown status is sent as unspecified and
+	// rejected by the vocabulary service.
+	stages := make([]*pb.StatusStage, len(req.Stages))
+	for i, stage := range req.Stages {
+		stages[i] = &pb.StatusStage{
+			Name:   stage.Name,
+			Status: parseStatus(stage.Status),
+			Next:   stage.Next,
+		}
+	}
+	grpcReq := &pb.SetStatusStagesRequest{
+		UserId: user.UserID,
+		Stages: stages,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := s.cfg.VocabServiceClient.SetStatusStages(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to set stages", http.StatusInternalServerError)
+		return
+	}
+
+	writeStagesResponse(w, resp)
+}
+
+// writeStagesResponse writes a stages gRPC response as JSON
+func writeStagesResponse(w http.ResponseWriter, resp *pb.StatusStagesResponse) {
+	stages := make([]Stage, len(resp.Stages))
+	for i, stage := range resp.Stages {
+		stages[i] = Stage{
+			Name:   stage.Name,
+			Status: statusName(stage.Status),
+			Next:   stage.Next,
+		}
+	}
+
+	response := StagesResponse{
+		Success: resp.Success,
+		Message: resp.Message,
+		Stages:  stages,
+		Custom:  resp.Custom,
+		Updated: resp.Updated,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+// GetVocabularyTransitions handles GET /vocab/{id}/transitions
+func (v *VocabHandler) GetVocabularyTransitions(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Extract vocab ID from URL path
+	vocabID, err := strconv.ParseUint(r.PathValue("id"), 10, 32)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Invalid vocabulary ID", http.StatusBadRequest)
+		return
+	}
+
+	// Parse query parameters
+	query := r.URL.Query()
+	var limit, offset int32
+	if l, err := strconv.Atoi(query.Get("limit")); err == nil {
+		limit = int32(l)
+	}
+	if o, err := strconv.Atoi(query.Get("offset")); err == nil {
+		offset = int32(o)
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.GetStatusTransitionsRequest{
+		UserId:       user.UserID,
+		VocabularyId: uint32(vocabID),
+		Limit:        limit,
+		Offset:       offset,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := v.cfg.VocabServiceClient.GetStatusTransitions(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to get vocabulary transitions", http.StatusInternalServerError)
+		return
+	}
+
+	// Convert response
+	transitions := make([]Transition, len(resp.Transitions))
+	for i, transition := range resp.Transitions {
+		transitions[i] = Transition{
+			ID:           transition.Id,
+			VocabularyID: transition.VocabularyId,
+			FromStage:    transition.FromStage,
+			ToStage:      transition.ToStage,
+			FromStatus:   statusName(transition.FromStatus),
+			ToStatus:     statusName(transition.ToStatus),
+			Source:       transition.Source,
+			CreatedAt:    transition.CreatedAt,
+		}
+	}
+
+	response := TransitionListResponse{
+		Success:     resp.Success,
+		Message:     resp.Message,
+		Transitions: transitions,
+		Total:       resp.Total,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+// statusPrefix is the prefix of the proto names of the statuses
+const statusPrefix = "VOCABULARY_STATUS_"
+
+// statusName returns the JSON name of a status, such as "review_needed", or
+// an empty string if it is unspecified
+func statusName(status pb.VocabularyStatus) string {
+	if status == pb.VocabularyStatus_VOCABULARY_STATUS_UNSPECIFIED {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(status.String(), statusPrefix))
+}
+
+// parseStatus returns the status with a JSON name, or unspecified if there
+// is none
+func parseStatus(name string) pb.VocabularyStatus {
+	if name == "" {
+		return pb.VocabularyStatus_VOCABULARY_STATUS_UNSPECIFIED
+	}
+	return pb.VocabularyStatus(pb.VocabularyStatus_value[statusPrefix+strings.ToUpper(name)])
+}
@@ -0,0 +1,255 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/vocal-tracker/broker-service/config"
+	"github.com/vocal-tracker/broker-service/middleware"
+	pb "github.com/vocal-tracker/broker-service/proto"
+)
+
+type StatsHandler struct {
+	cfg *config.Config
+}
+
+// Request types
+type DailyGoalRequest struct {
+	DailyGoal int32 `json:"daily_goal"`
+}
+
+// Response types
+type DailyGoalResponse struct {
+	Success   bool   `json:"success"`
+	Message   string `json:"message"`
+	DailyGoal int32  `json:"daily_goal"`
+}
+
+type StatsResponse struct {
+	Success               bool             `json:"success"`
+	Message               string           `json:"message"`
+	TotalWords            int32            `json:"total_words"`
+	WordsThisWeek         int32            `json:"words_this_week"`
+	WordsThisMonth        int32            `json:"words_this_month"`
+	StatusCounts          map[string]int32 `json:"status_counts"`
+	StageCounts           map[string]int32 `json:"stage_counts"`
+	DailyCounts           []DailyCount     `json:"daily_counts"`
+	TotalReviews          int32            `json:"total_reviews"`
+	AccuracyRate          float64          `json:"accuracy_rate"`
+	AverageResponseTimeMs float64          `json:"average_response_time_ms"`
+	HardestWords          []HardWord       `json:"hardest_words"`
+	CurrentStreak         int32            `json:"current_streak"`
+	LongestStreak         int32            `json:"longest_streak"`
+	DailyGoal             int32            `json:"daily_goal"`
+	TodayProgress         int32            `json:"today_progress"`
+}
+
+type ProgressResponse struct {
+	Success  bool            `json:"success"`
+	Message  string          `json:"message"`
+	Interval string          `json:"interval"`
+	Points   []ProgressPoint `json:"points"`
+}
+
+type ProgressPoint struct {
+	Date         string           `json:"date"`
+	StatusCounts map[string]int32 `json:"status_counts"`
+	Total        int32            `json:"total"`
+}
+
+type DailyCount struct {
+	Date  string `json:"date"`
+	Count int32  `json:"count"`
+}
+
+type HardWord struct {
+	VocabularyID uint32  `json:"vocabulary_id"`
+	Word         string  `json:"word"`
+	Attempts     int32   `json:"attempts"`
+	Failures     int32   `json:"failures"`
+	AccuracyRate float64 `json:"accuracy_rate"`
+}
+
+func NewStatsHandler(cfg *config.Config) *StatsHandler {
+	return &StatsHandler{cfg: cfg}
+}
+
+// GetVocabularyStats handles GET /stats
+func (s *StatsHandler) GetVocabularyStats(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.GetVocabularyStatsRequest{
+		UserId:   user.UserID,
+		DateFrom: r.URL.Query().Get("date_from"),
+		DateTo:   r.URL.Query().Get("date_to"),
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := s.cfg.VocabServiceClient.GetVocabularyStats(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to get statistics", http.StatusInternalServerError)
+		return
+	}
+
+	// Convert response
+	dailyCounts := make([]DailyCount, len(resp.DailyCounts))
+	for i, daily := range resp.DailyCounts {
+		dailyCounts[i] = DailyCount{
+			Date:  daily.Date,
+			Count: daily.Count,
+		}
+	}
+
+	hardestWords := make([]HardWord, len(resp.HardestWords))
+	for i, word := range resp.HardestWords {
+		hardestWords[i] = HardWord{
+			VocabularyID: word.VocabularyId,
+			Word:         word.Word,
+			Attempts:     word.Attempts,
+			Failures:     word.Failures,
+			AccuracyRate: word.AccuracyRate,
+		}
+	}
+
+	response := StatsResponse{
+		Success:               resp.Success,
+		Message:               resp.Message,
+		TotalWords:            resp.TotalWords,
+		WordsThisWeek:         resp.WordsThisWeek,
+		WordsThisMonth:        resp.WordsThisMonth,
+		StatusCounts:          resp.StatusCounts,
+		StageCounts:           resp.StageCounts,
+		DailyCounts:           dailyCounts,
+		TotalReviews:          resp.TotalReviews,
+		AccuracyRate:          resp.AccuracyRate,
+		AverageResponseTimeMs: resp.AverageResponseTimeMs,
+		HardestWords:          hardestWords,
+		CurrentStreak:         resp.CurrentStreak,
+		LongestStreak:         resp.LongestStreak,
+		DailyGoal:             resp.DailyGoal,
+		TodayProgress:         resp.TodayProgress,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+// GetProgressTimeline handles GET /stats/progress
+func (s *StatsHandler) GetProgressTimeline(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Create gRPC request
+	query := r.URL.Query()
+	grpcReq := &pb.GetProgressTimelineRequest{
+		UserId:   user.UserID,
+		DateFrom: query.Get("date_from"),
+		DateTo:   query.Get("date_to"),
+		Interval: query.Get("interval"),
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := s.cfg.VocabServiceClient.GetProgressTimeline(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to get progress timeline", http.StatusInternalServerError)
+		return
+	}
+
+	// Convert response
+	points := make([]ProgressPoint, len(resp.Points))
+	for i, point := range resp.Points {
+		points[i] = ProgressPoint{
+			Date:         point.Date,
+			StatusCounts: point.StatusCounts,
+			Total:        point.Total,
+		}
+	}
+
+	response := ProgressResponse{
+		Success:  resp.Success,
+		Message:  resp.Message,
+		Interval: resp.Interval,
+		Points:   points,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+// SetDailyGoal handles PUT /stats/goal
+func (s *StatsHandler) SetDailyGoal(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse request body
+	var req DailyGoalRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		middleware.WriteErrorResponse(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.SetDailyGoalRequest{
+		UserId:    user.UserID,
+		DailyGoal: req.DailyGoal,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := s.cfg.VocabServiceClient.SetDailyGoal(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to set daily goal", http.StatusInternalServerError)
+		return
+	}
+
+	response := DailyGoalResponse{
+		Success:   resp.Success,
+		Message:   resp.Message,
+		DailyGoal: resp.DailyGoal,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
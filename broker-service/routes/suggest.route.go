@@ -0,0 +1,94 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/vocal-tracker/broker-service/middleware"
+	pb "github.com/vocal-tracker/broker-service/proto"
+)
+
+// suggestTimeout is kept short: a suggestion that arrives late has already
+// been replaced by the next keystroke
+const suggestTimeout = 3 * time.Second
+
+// Response types
+type SuggestWordsResponse struct {
+	Success     bool             `json:"success"`
+	Message     string           `json:"message"`
+	Suggestions []WordSuggestion `json:"suggestions"`
+}
+
+type WordSuggestion struct {
+	ID          uint32 `json:"id"`
+	Word        string `json:"word"`
+	Meaning     string `json:"meaning"`
+	Status      string `json:"status"`
+	ReviewCount int32  `json:"review_count"`
+}
+
+// SuggestWords handles GET /vocab/suggest
+func (v *VocabHandler) SuggestWords(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	var limit int32
+	if l, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil {
+		limit = int32(l)
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.SuggestWordsRequest{
+		UserId: user.UserID,
+		Prefix: r.URL.Query().Get("q"),
+		Limit:  limit,
+		Sort:   r.URL.Query().Get("sort"),
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), suggestTimeout)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := v.cfg.VocabServiceClient.SuggestWords(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to get suggestions", http.StatusInternalServerError)
+		return
+	}
+
+	// Convert response
+	suggestions := make([]WordSuggestion, len(resp.Suggestions))
+	for i, suggestion := range resp.Suggestions {
+		suggestions[i] = WordSuggestion{
+			ID:          suggestion.Id,
+			Word:        suggestion.Word,
+			Meaning:     suggestion.Meaning,
+			Status:      statusName(suggestion.Status),
+			ReviewCount: suggestion.ReviewCount,
+		}
+	}
+
+	response := SuggestWordsResponse{
+		Success:     resp.Success,
+		Message:     resp.Message,
+		Suggestions: suggestions,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	} else {
+		// Typing and deleting a character asks for the same prefix again
+		w.Header().Set("Cache-Control", "private, max-age=10")
+	}
+	json.NewEncoder(w).Encode(response)
+}
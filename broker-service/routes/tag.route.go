@@ -0,0 +1,227 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vocal-tracker/broker-service/config"
+	"github.com/vocal-tracker/broker-service/middleware"
+	pb "github.com/vocal-tracker/broker-service/proto"
+)
+
+type TagHandler struct {
+	cfg *config.Config
+}
+
+// Request types
+type RenameTagRequest struct {
+	Name string `json:"name"`
+}
+
+// Response types
+type TagListResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+	Tags    []Tag  `json:"tags"`
+}
+
+type TagResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+	Tag     *Tag   `json:"tag,omitempty"`
+}
+
+type Tag struct {
+	ID              uint32 `json:"id"`
+	Name            string `json:"name"`
+	VocabularyCount int32  `json:"vocabulary_count"`
+}
+
+func NewTagHandler(cfg *config.Config) *TagHandler {
+	return &TagHandler{cfg: cfg}
+}
+
+// ListTags handles GET /tags
+func (t *TagHandler) ListTags(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.ListTagsRequest{
+		UserId: user.UserID,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := t.cfg.VocabServiceClient.ListTags(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to get tags", http.StatusInternalServerError)
+		return
+	}
+
+	// Convert response
+	tags := make([]Tag, len(resp.Tags))
+	for i, tag := range resp.Tags {
+		tags[i] = *toTag(tag)
+	}
+
+	response := TagListResponse{
+		Success: resp.Success,
+		Message: resp.Message,
+		Tags:    tags,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+// RenameTag handles PUT /tags/{id}
+func (t *TagHandler) RenameTag(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Extract tag ID from URL path
+	tagID, err := strconv.ParseUint(r.PathValue("id"), 10, 32)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Invalid tag ID", http.StatusBadRequest)
+		return
+	}
+
+	// Parse request body
+	var req RenameTagRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		middleware.WriteErrorResponse(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Validate required fields
+	if strings.TrimSpace(req.Name) == "" {
+		middleware.WriteErrorResponse(w, "Name is required", http.StatusBadRequest)
+		return
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.RenameTagRequest{
+		UserId: user.UserID,
+		TagId:  uint32(tagID),
+		Name:   req.Name,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := t.cfg.VocabServiceClient.RenameTag(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to rename tag", http.StatusInternalServerError)
+		return
+	}
+
+	response := TagResponse{
+		Success: resp.Success,
+		Message: resp.Message,
+		Tag:     toTag(resp.Tag),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+// DeleteTag handles DELETE /tags/{id}
+func (t *TagHandler) DeleteTag(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Extract tag ID from URL path
+	tagID, err := strconv.ParseUint(r.PathValue("id"), 10, 32)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Invalid tag ID", http.StatusBadRequest)
+		return
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.DeleteTagRequest{
+		UserId: user.UserID,
+		TagId:  uint32(tagID),
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := t.cfg.VocabServiceClient.DeleteTag(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to delete tag", http.StatusInternalServerError)
+		return
+	}
+
+	response := map[string]interface{}{
+		"success": resp.Success,
+		"message": resp.Message,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+// toTag converts a gRPC tag to its JSON representation
+func toTag(tag *pb.Tag) *Tag {
+	if tag == nil {
+		return nil
+	}
+	return &Tag{
+		ID:              tag.Id,
+		Name:            tag.Name,
+		VocabularyCount: tag.VocabularyCount,
+	}
+}
+
+// parseTagsParam collects tag names from repeated or comma-separated
+// "tags" query parameters
+func parseTagsParam(r *http.Request) []string {
+	var tags []string
+	for _, value := range r.URL.Query()["tags"] {
+		for _, tag := range strings.Split(value, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				tags = append(tags, tag)
+			}
+		}
+	}
+	return tags
+}
@@ -0,0 +1,194 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/vocal-tracker/broker-service/middleware"
+	pb "github.com/vocal-tracker/broker-service/proto"
+)
+
+// Request types
+type PurgeTrashRequest struct {
+	// VocabularyIDs limits the purge to these entries; leave it out to empty the trash
+	VocabularyIDs []uint32 `json:"vocabulary_ids,omitempty"`
+}
+
+// Response types
+type TrashListResponse struct {
+	Success       bool         `json:"success"`
+	Message       string       `json:"message"`
+	Vocabularies  []Vocabulary `json:"vocabularies"`
+	Total         int32        `json:"total"`
+	RetentionDays int32        `json:"retention_days"`
+}
+
+type PurgeTrashResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+	Purged  int32  `json:"purged"`
+}
+
+// ListTrash handles GET /vocab/trash
+func (v *VocabHandler) ListTrash(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Paging works as in GET /vocab
+	page := parseVocabListQuery(r, user.UserID)
+
+	// Create gRPC request
+	grpcReq := &pb.ListTrashRequest{
+		UserId: user.UserID,
+		Limit:  page.Limit,
+		Offset: page.Offset,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := v.cfg.VocabServiceClient.ListTrash(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to get trash", http.StatusInternalServerError)
+		return
+	}
+
+	// Convert response
+	vocabs := make([]Vocabulary, len(resp.Vocabularies))
+	for i, vocab := range resp.Vocabularies {
+		vocabs[i] = *toVocabulary(vocab)
+	}
+
+	response := TrashListResponse{
+		Success:       resp.Success,
+		Message:       resp.Message,
+		Vocabularies:  vocabs,
+		Total:         resp.Total,
+		RetentionDays: resp.RetentionDays,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+// RestoreVocabulary handles POST /vocab/trash/{id}/restore
+func (v *VocabHandler) RestoreVocabulary(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Extract vocab ID from URL path
+	vocabID, err := strconv.ParseUint(r.PathValue("id"), 10, 32)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Invalid vocabulary ID", http.StatusBadRequest)
+		return
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.RestoreVocabularyRequest{
+		UserId:       user.UserID,
+		VocabularyId: uint32(vocabID),
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := v.cfg.VocabServiceClient.RestoreVocabulary(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to restore vocabulary", http.StatusInternalServerError)
+		return
+	}
+
+	response := VocabResponse{
+		Success:   resp.Success,
+		Message:   resp.Message,
+		Vocab:     toVocabulary(resp.Vocabulary),
+		Duplicate: resp.Duplicate,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Duplicate {
+		w.WriteHeader(http.StatusConflict)
+	} else if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+// PurgeTrash handles DELETE /vocab/trash and DELETE /vocab/trash/{id}
+func (v *VocabHandler) PurgeTrash(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// A single entry from the path, or the entries in the optional body
+	var req PurgeTrashRequest
+	if id := r.PathValue("id"); id != "" {
+		vocabID, err := strconv.ParseUint(id, 10, 32)
+		if err != nil {
+			middleware.WriteErrorResponse(w, "Invalid vocabulary ID", http.StatusBadRequest)
+			return
+		}
+		req.VocabularyIDs = []uint32{uint32(vocabID)}
+	} else if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			middleware.WriteErrorResponse(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.PurgeTrashRequest{
+		UserId:        user.UserID,
+		VocabularyIds: req.VocabularyIDs,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := v.cfg.VocabServiceClient.PurgeTrash(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to purge trash", http.StatusInternalServerError)
+		return
+	}
+
+	response := PurgeTrashResponse{
+		Success: resp.Success,
+		Message: resp.Message,
+		Purged:  resp.Purged,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
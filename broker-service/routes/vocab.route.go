@@ -3,7 +3,9 @@ package routes
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -11,6 +13,7 @@ import (
 	"github.com/vocal-tracker/broker-service/config"
 	"github.com/vocal-tracker/broker-service/middleware"
 	pb "github.com/vocal-tracker/broker-service/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 type VocabHandler struct {
@@ -19,11 +22,15 @@ type VocabHandler struct {
 
 // Request types
 type CreateVocabRequest struct {
-	Word    string `json:"word"`
-	Meaning string `json:"meaning"`
-	Example string `json:"example"`
-	Date    string `json:"date"`
-	Status  string `json:"status,omitempty"`
+	Word    string   `json:"word"`
+	Meaning string   `json:"meaning"`
+	Example string   `json:"example"`
+	Date    string   `json:"date"`
+	Status  string   `json:"status,omitempty"`
+	Tags    []string `json:"tags,omitempty"`
+	DeckIDs []uint32 `json:"deck_ids,omitempty"`
+	// OnDuplicate is "reject" (default) or "merge"
+	OnDuplicate string `json:"on_duplicate,omitempty"`
 }
 
 type UpdateVocabRequest struct {
@@ -31,13 +38,22 @@ type UpdateVocabRequest struct {
 	Meaning string `json:"meaning"`
 	Example string `json:"example"`
 	Status  string `json:"status"`
+	// Tags replaces the entry's tags. PUT clears them when it is missing or
+	// empty; a batch update keeps them when it is missing.
+	Tags *[]string `json:"tags"`
+	// Version is the version of the entry the update was made from; the
+	// update fails with a conflict if the entry has changed since
+	Version int32 `json:"version,omitempty"`
 }
 
 // Response types
 type VocabResponse struct {
-	Success bool        `json:"success"`
-	Message string      `json:"message"`
-	Vocab   *Vocabulary `json:"vocabulary,omitempty"`
+	Success   bool        `json:"success"`
+	Message   string      `json:"message"`
+	Vocab     *Vocabulary `json:"vocabulary,omitempty"`
+	Duplicate bool        `json:"duplicate,omitempty"`
+	Merged    bool        `json:"merged,omitempty"`
+	Conflict  bool        `json:"conflict,omitempty"`
 }
 
 type VocabListResponse struct {
@@ -46,18 +62,33 @@ type VocabListResponse struct {
 	Vocabularies []Vocabulary `json:"vocabularies"`
 	Count        int32        `json:"count"`
 	Total        int32        `json:"total"`
+	// ErrorPosition is where q could not be parsed, counting from 1
+	ErrorPosition int32 `json:"error_position,omitempty"`
+	// NextCursor fetches the next page as the cursor query parameter
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 type Vocabulary struct {
-	ID        uint32 `json:"id"`
-	UserID    uint32 `json:"user_id"`
-	Word      string `json:"word"`
-	Meaning   string `json:"meaning"`
-	Example   string `json:"example"`
-	Date      string `json:"date"`
-	Status    string `json:"status"`
-	CreatedAt string `json:"created_at"`
-	UpdatedAt string `json:"updated_at"`
+	ID              uint32            `json:"id"`
+	UserID          uint32            `json:"user_id"`
+	Word            string            `json:"word"`
+	Meaning         string            `json:"meaning"`
+	Example         string            `json:"example"`
+	Date            string            `json:"date"`
+	Status          string            `json:"status"`
+	Stage           string            `json:"stage"`
+	CreatedAt       string            `json:"created_at"`
+	UpdatedAt       string            `json:"updated_at"`
+	EaseFactor      float64           `json:"ease_factor"`
+	IntervalDays    int32             `json:"interval_days"`
+	Repetitions     int32             `json:"repetitions"`
+	NextReviewAt    string            `json:"next_review_at,omitempty"`
+	LastReviewedAt  string            `json:"last_reviewed_at,omitempty"`
+	FirstReviewedAt string            `json:"first_reviewed_at,omitempty"`
+	Tags            []string          `json:"tags"`
+	Highlights      map[string]string `json:"highlights,omitempty"`
+	DeletedAt       string            `json:"deleted_at,omitempty"`
+	Version         int32             `json:"version"`
 }
 
 func NewVocabHandler(cfg *config.Config) *VocabHandler {
@@ -74,34 +105,13 @@ func (v *VocabHandler) GetVocabularies(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Parse query parameters
-	date := r.URL.Query().Get("date")
-	search := r.URL.Query().Get("search")
-	limitStr := r.URL.Query().Get("limit")
-	offsetStr := r.URL.Query().Get("offset")
-
-	var limit, offset int32 = 50, 0 // defaults
-
-	if limitStr != "" {
-		if l, err := strconv.Atoi(limitStr); err == nil {
-			limit = int32(l)
-		}
-	}
-
-	if offsetStr != "" {
-		if o, err := strconv.Atoi(offsetStr); err == nil {
-			offset = int32(o)
-		}
-	}
+	grpcReq := parseVocabListQuery(r, user.UserID)
 
-	// Create gRPC request
-	grpcReq := &pb.GetVocabulariesRequest{
-		UserId: user.UserID,
-		Date:   date,
-		Search: search,
-		Limit:  limit,
-		Offset: offset,
-	}
+	listVocabularies(w, r, v.cfg, grpcReq)
+}
 
+// listVocabularies fetches a page of vocabularies and writes it as JSON
+func listVocabularies(w http.ResponseWriter, r *http.Request, cfg *config.Config, grpcReq *pb.GetVocabulariesRequest) {
 	// Call vocabulary service with authenticated context
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -109,40 +119,90 @@ func (v *VocabHandler) GetVocabularies(w http.ResponseWriter, r *http.Request) {
 	// Forward JWT token to vocabulary service
 	ctx = middleware.CreateAuthenticatedContext(ctx, r)
 
-	resp, err := v.cfg.VocabServiceClient.GetVocabularies(ctx, grpcReq)
+	resp, err := cfg.VocabServiceClient.GetVocabularies(ctx, grpcReq)
 	if err != nil {
 		middleware.WriteErrorResponse(w, "Failed to get vocabularies", http.StatusInternalServerError)
 		return
 	}
 
+	writeVocabListResponse(w, r, resp)
+}
+
+// writeVocabListResponse writes a vocabulary list gRPC response as JSON. When
+// there is a next page, its URL is also sent in a Link header.
+func writeVocabListResponse(w http.ResponseWriter, r *http.Request, resp *pb.GetVocabulariesResponse) {
 	// Convert response
 	vocabularies := make([]Vocabulary, len(resp.Vocabularies))
 	for i, vocab := range resp.Vocabularies {
-		vocabularies[i] = Vocabulary{
-			ID:        vocab.Id,
-			UserID:    vocab.UserId,
-			Word:      vocab.Word,
-			Meaning:   vocab.Meaning,
-			Example:   vocab.Example,
-			Date:      vocab.Date,
-			Status:    vocab.Status,
-			CreatedAt: vocab.CreatedAt,
-			UpdatedAt: vocab.UpdatedAt,
-		}
+		vocabularies[i] = *toVocabulary(vocab)
 	}
 
 	response := VocabListResponse{
-		Success:      resp.Success,
-		Message:      resp.Message,
-		Vocabularies: vocabularies,
-		Count:        resp.Count,
-		Total:        resp.Total,
+		Success:       resp.Success,
+		Message:       resp.Message,
+		Vocabularies:  vocabularies,
+		Count:         resp.Count,
+		Total:         resp.Total,
+		ErrorPosition: resp.ErrorPosition,
+		NextCursor:    resp.NextPageToken,
 	}
 
 	w.Header().Set("Content-Type", "application/json")
+	if resp.NextPageToken != "" {
+		query := r.URL.Query()
+		query.Set("cursor", resp.NextPageToken)
+		query.Del("offset")
+		next := url.URL{Path: r.URL.Path, RawQuery: query.Encode()}
+		w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="next"`, next.String()))
+	}
+	if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
 	json.NewEncoder(w).Encode(response)
 }
 
+// parseVocabListQuery builds a vocabulary list request from the filter and
+// pagination query parameters
+func parseVocabListQuery(r *http.Request, userID uint32) *pb.GetVocabulariesRequest {
+	limitStr := r.URL.Query().Get("limit")
+	offsetStr := r.URL.Query().Get("offset")
+
+	var limit, offset int32 = 50, 0 // defaults
+
+	if limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil {
+			limit = int32(l)
+		}
+	}
+
+	if offsetStr != "" {
+		if o, err := strconv.Atoi(offsetStr); err == nil {
+			offset = int32(o)
+		}
+	}
+
+	var deckID uint32
+	if d, err := strconv.ParseUint(r.URL.Query().Get("deck_id"), 10, 32); err == nil {
+		deckID = uint32(d)
+	}
+
+	return &pb.GetVocabulariesRequest{
+		UserId:    userID,
+		Date:      r.URL.Query().Get("date"),
+		Search:    r.URL.Query().Get("search"),
+		Q:         r.URL.Query().Get("q"),
+		Status:    r.URL.Query().Get("status"),
+		Limit:     limit,
+		Offset:    offset,
+		Tags:      parseTagsParam(r),
+		TagMatch:  r.URL.Query().Get("tag_match"),
+		DeckId:    deckID,
+		SortBy:    r.URL.Query().Get("sort_by"),
+		SortOrder: r.URL.Query().Get("sort_order"),
+		PageToken: r.URL.Query().Get("cursor"),
+	}
+}
+
 // CreateVocabulary handles POST /vocab
 func (v *VocabHandler) CreateVocabulary(w http.ResponseWriter, r *http.Request) {
 	// Get authenticated user
@@ -165,19 +225,17 @@ func (v *VocabHandler) CreateVocabulary(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	// Set default status if not provided
-	if req.Status == "" {
-		req.Status = "review_needed"
-	}
-
 	// Create gRPC request
 	grpcReq := &pb.CreateVocabularyRequest{
-		UserId:  user.UserID,
-		Word:    req.Word,
-		Meaning: req.Meaning,
-		Example: req.Example,
-		Date:    req.Date,
-		Status:  req.Status,
+		UserId:      user.UserID,
+		Word:        req.Word,
+		Meaning:     req.Meaning,
+		Example:     req.Example,
+		Date:        req.Date,
+		Status:      req.Status,
+		Tags:        req.Tags,
+		DeckIds:     req.DeckIDs,
+		OnDuplicate: req.OnDuplicate,
 	}
 
 	// Call vocabulary service with authenticated context
@@ -193,36 +251,25 @@ func (v *VocabHandler) CreateVocabulary(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	// Convert response
-	var vocab *Vocabulary
-	if resp.Vocabulary != nil {
-		vocab = &Vocabulary{
-			ID:        resp.Vocabulary.Id,
-			UserID:    resp.Vocabulary.UserId,
-			Word:      resp.Vocabulary.Word,
-			Meaning:   resp.Vocabulary.Meaning,
-			Example:   resp.Vocabulary.Example,
-			Date:      resp.Vocabulary.Date,
-			Status:    resp.Vocabulary.Status,
-			CreatedAt: resp.Vocabulary.CreatedAt,
-			UpdatedAt: resp.Vocabulary.UpdatedAt,
-		}
-	}
-
 	response := VocabResponse{
-		Success: resp.Success,
-		Message: resp.Message,
-		Vocab:   vocab,
+		Success:   resp.Success,
+		Message:   resp.Message,
+		Vocab:     toVocabulary(resp.Vocabulary),
+		Duplicate: resp.Duplicate,
+		Merged:    resp.Merged,
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	if !resp.Success {
+	if resp.Duplicate {
+		w.WriteHeader(http.StatusConflict)
+	} else if !resp.Success {
 		w.WriteHeader(http.StatusBadRequest)
 	}
 	json.NewEncoder(w).Encode(response)
 }
 
-// UpdateVocabulary handles PUT /vocab/{id}
+// UpdateVocabulary handles PUT /vocab/{id}, replacing the entry's word,
+// meaning, example and tags, and its status when given
 func (v *VocabHandler) UpdateVocabulary(w http.ResponseWriter, r *http.Request) {
 	// Get authenticated user
 	user, ok := middleware.GetUserFromContext(r.Context())
@@ -252,7 +299,7 @@ func (v *VocabHandler) UpdateVocabulary(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	// Create gRPC request
+	// Create gRPC request; an empty example or missing tags clear them
 	grpcReq := &pb.UpdateVocabularyRequest{
 		VocabularyId: uint32(vocabID),
 		UserId:       user.UserID,
@@ -260,6 +307,114 @@ func (v *VocabHandler) UpdateVocabulary(w http.ResponseWriter, r *http.Request)
 		Meaning:      req.Meaning,
 		Example:      req.Example,
 		Status:       req.Status,
+		UpdateMask:   &fieldmaskpb.FieldMask{Paths: []string{"word", "meaning", "example", "tags"}},
+	}
+	if req.Status != "" {
+		grpcReq.UpdateMask.Paths = append(grpcReq.UpdateMask.Paths, "status")
+	}
+	if req.Tags != nil {
+		grpcReq.Tags = *req.Tags
+	}
+
+	v.sendVocabularyUpdate(w, r, grpcReq, req.Version)
+}
+
+// PatchVocabulary handles PATCH /vocab/{id}. The body is a JSON merge patch
+// (RFC 7396): only the members present are changed, and null removes the
+// example or the tags.
+func (v *VocabHandler) PatchVocabulary(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Extract vocab ID from URL path
+	vocabID, err := strconv.ParseUint(r.PathValue("id"), 10, 32)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Invalid vocabulary ID", http.StatusBadRequest)
+		return
+	}
+
+	// Parse request body, keeping track of which members are present
+	var patch map[string]json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		middleware.WriteErrorResponse(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Create gRPC request with a mask of the patched fields
+	grpcReq := &pb.UpdateVocabularyRequest{
+		VocabularyId: uint32(vocabID),
+		UserId:       user.UserID,
+		UpdateMask:   &fieldmaskpb.FieldMask{},
+	}
+	var version int32
+	for field, value := range patch {
+		null := string(value) == "null"
+		var err error
+		switch field {
+		case "word", "meaning", "status":
+			if null {
+				middleware.WriteErrorResponse(w, fmt.Sprintf("Cannot remove %s", field), http.StatusBadRequest)
+				return
+			}
+			var text string
+			err = json.Unmarshal(value, &text)
+			switch field {
+			case "word":
+				grpcReq.Word = text
+			case "meaning":
+				grpcReq.Meaning = text
+			default:
+				grpcReq.Status = text
+			}
+		case "example":
+			if !null {
+				err = json.Unmarshal(value, &grpcReq.Example)
+			}
+		case "tags":
+			if !null {
+				err = json.Unmarshal(value, &grpcReq.Tags)
+			}
+		case "version":
+			err = json.Unmarshal(value, &version)
+		default:
+			middleware.WriteErrorResponse(w, fmt.Sprintf("Unknown field %q", field), http.StatusBadRequest)
+			return
+		}
+		if err != nil {
+			middleware.WriteErrorResponse(w, fmt.Sprintf("Invalid value for %s", field), http.StatusBadRequest)
+			return
+		}
+		if field != "version" {
+			grpcReq.UpdateMask.Paths = append(grpcReq.UpdateMask.Paths, field)
+		}
+	}
+	if len(grpcReq.UpdateMask.Paths) == 0 {
+		middleware.WriteErrorResponse(w, "No fields to update", http.StatusBadRequest)
+		return
+	}
+
+	v.sendVocabularyUpdate(w, r, grpcReq, version)
+}
+
+// sendVocabularyUpdate sends grpcReq to the vocabulary service and writes the
+// response. The update is checked against the version in an If-Match header,
+// or else bodyVersion when it is set.
+func (v *VocabHandler) sendVocabularyUpdate(w http.ResponseWriter, r *http.Request, grpcReq *pb.UpdateVocabularyRequest, bodyVersion int32) {
+	// An If-Match header takes precedence over the version in the body
+	grpcReq.ExpectedVersion = bodyVersion
+	ifMatch := r.Header.Get("If-Match")
+	precondition := ifMatch != "" && ifMatch != "*"
+	if precondition {
+		version, ok := parseVocabETag(ifMatch)
+		if !ok {
+			middleware.WriteErrorResponse(w, "Invalid If-Match header", http.StatusBadRequest)
+			return
+		}
+		grpcReq.ExpectedVersion = version
 	}
 
 	// Call vocabulary service with authenticated context
@@ -275,26 +430,77 @@ func (v *VocabHandler) UpdateVocabulary(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	// Convert response
-	var vocab *Vocabulary
-	if resp.Vocabulary != nil {
-		vocab = &Vocabulary{
-			ID:        resp.Vocabulary.Id,
-			UserID:    resp.Vocabulary.UserId,
-			Word:      resp.Vocabulary.Word,
-			Meaning:   resp.Vocabulary.Meaning,
-			Example:   resp.Vocabulary.Example,
-			Date:      resp.Vocabulary.Date,
-			Status:    resp.Vocabulary.Status,
-			CreatedAt: resp.Vocabulary.CreatedAt,
-			UpdatedAt: resp.Vocabulary.UpdatedAt,
+	response := VocabResponse{
+		Success:   resp.Success,
+		Message:   resp.Message,
+		Vocab:     toVocabulary(resp.Vocabulary),
+		Duplicate: resp.Duplicate,
+		Conflict:  resp.Conflict,
+	}
+
+	// On a conflict the body is the current entry, tagged with its version
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Vocabulary != nil && !resp.Duplicate {
+		w.Header().Set("ETag", vocabETag(resp.Vocabulary))
+	}
+	if resp.Conflict && precondition {
+		w.WriteHeader(http.StatusPreconditionFailed)
+	} else if resp.Conflict || resp.Duplicate {
+		w.WriteHeader(http.StatusConflict)
+	} else if !resp.Success {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+// GetVocabulary handles GET /vocab/{id}
+func (v *VocabHandler) GetVocabulary(w http.ResponseWriter, r *http.Request) {
+	// Get authenticated user
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok {
+		middleware.WriteErrorResponse(w, "User not found in context", http.StatusUnauthorized)
+		return
+	}
+
+	// Extract vocab ID from URL path
+	vocabID, err := strconv.ParseUint(r.PathValue("id"), 10, 32)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Invalid vocabulary ID", http.StatusBadRequest)
+		return
+	}
+
+	// Create gRPC request
+	grpcReq := &pb.GetVocabularyByIdRequest{
+		VocabularyId: uint32(vocabID),
+		UserId:       user.UserID,
+	}
+
+	// Call vocabulary service with authenticated context
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Forward JWT token to vocabulary service
+	ctx = middleware.CreateAuthenticatedContext(ctx, r)
+
+	resp, err := v.cfg.VocabServiceClient.GetVocabularyById(ctx, grpcReq)
+	if err != nil {
+		middleware.WriteErrorResponse(w, "Failed to get vocabulary", http.StatusInternalServerError)
+		return
+	}
+
+	if resp.Success && resp.Vocabulary != nil {
+		etag := vocabETag(resp.Vocabulary)
+		w.Header().Set("ETag", etag)
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
 		}
 	}
 
 	response := VocabResponse{
 		Success: resp.Success,
 		Message: resp.Message,
-		Vocab:   vocab,
+		Vocab:   toVocabulary(resp.Vocabulary),
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -304,7 +510,7 @@ func (v *VocabHandler) UpdateVocabulary(w http.ResponseWriter, r *http.Request)
 	json.NewEncoder(w).Encode(response)
 }
 
-// DeleteVocabulary handles DELETE /vocab/{id}
+// DeleteVocabulary handles DELETE /vocab/{id}, moving the entry to the trash
 func (v *VocabHandler) DeleteVocabulary(w http.ResponseWriter, r *http.Request) {
 	// Get authenticated user
 	user, ok := middleware.GetUserFromContext(r.Context())
@@ -351,3 +557,52 @@ func (v *VocabHandler) DeleteVocabulary(w http.ResponseWriter, r *http.Request)
 	}
 	json.NewEncoder(w).Encode(response)
 }
+
+// vocabETag returns the entity tag of a vocabulary entry, which changes with
+// every new version of it
+func vocabETag(vocab *pb.Vocabulary) string {
+	return fmt.Sprintf("\"%d\"", vocab.Version)
+}
+
+// parseVocabETag returns the version in an entity tag from vocabETag. Only
+// the first tag of a list is used.
+func parseVocabETag(header string) (int32, bool) {
+	tag := strings.TrimSpace(strings.Split(header, ",")[0])
+	if len(tag) < 2 || !strings.HasPrefix(tag, `"`) || !strings.HasSuffix(tag, `"`) {
+		return 0, false
+	}
+	version, err := strconv.ParseInt(tag[1:len(tag)-1], 10, 32)
+	if err != nil || version <= 0 {
+		return 0, false
+	}
+	return int32(version), true
+}
+
+// toVocabulary converts a gRPC vocabulary to its JSON representation
+func toVocabulary(vocab *pb.Vocabulary) *Vocabulary {
+	if vocab == nil {
+		return nil
+	}
+	return &Vocabulary{
+		ID:              vocab.Id,
+		UserID:          vocab.UserId,
+		Word:            vocab.Word,
+		Meaning:         vocab.Meaning,
+		Example:         vocab.Example,
+		Date:            vocab.Date,
+		Status:          statusName(vocab.Status),
+		Stage:           vocab.Stage,
+		CreatedAt:       vocab.CreatedAt,
+		UpdatedAt:       vocab.UpdatedAt,
+		EaseFactor:      vocab.EaseFactor,
+		IntervalDays:    vocab.IntervalDays,
+		Repetitions:     vocab.Repetitions,
+		NextReviewAt:    vocab.NextReviewAt,
+		LastReviewedAt:  vocab.LastReviewedAt,
+		FirstReviewedAt: vocab.FirstReviewedAt,
+		Tags:            append([]string{}, vocab.Tags...),
+		Highlights:      vocab.Highlights,
+		DeletedAt:       vocab.DeletedAt,
+		Version:         vocab.Version,
+	}
+}
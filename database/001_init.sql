@@ -1,30 +1,200 @@
--- Create users table
+-- Schema of the vocab_tracker database. The auth and vocabulary services
+-- create the same schema with GORM's AutoMigrate when they start, so any
+-- change here must match their models, and the other way round.
+
+-- Create users table (auth-service)
 CREATE TABLE users (
-    id SERIAL PRIMARY KEY,
-    email VARCHAR(255) UNIQUE NOT NULL,
-    password_hash VARCHAR(255) NOT NULL,
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+    id BIGSERIAL PRIMARY KEY,
+    email TEXT NOT NULL,
+    password_hash TEXT NOT NULL,
+    created_at TIMESTAMPTZ,
+    CONSTRAINT uni_users_email UNIQUE (email)
 );
 
--- Create vocabulary table
-CREATE TABLE vocabulary (
-    id SERIAL PRIMARY KEY,
-    user_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
-    word VARCHAR(255) NOT NULL,
+-- Create vocabularies table. The status is one of the built-in statuses;
+-- the stage is the user's name for it, one of their custom stages or the
+-- status itself.
+CREATE TABLE vocabularies (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    word TEXT NOT NULL,
     meaning TEXT NOT NULL,
     example TEXT,
     date DATE NOT NULL,
-    status VARCHAR(20) DEFAULT 'review_needed' CHECK (status IN ('review_needed', 'mastered')),
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+    status TEXT NOT NULL DEFAULT 'review_needed',
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    stage TEXT NOT NULL DEFAULT '',
+    normalized_word TEXT NOT NULL DEFAULT '',
+    ease_factor DECIMAL NOT NULL DEFAULT 2.5,
+    interval_days BIGINT NOT NULL DEFAULT 0,
+    repetitions BIGINT NOT NULL DEFAULT 0,
+    next_review_at TIMESTAMPTZ,
+    last_reviewed_at TIMESTAMPTZ,
+    first_reviewed_at TIMESTAMPTZ,
+    version BIGINT NOT NULL DEFAULT 1,
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT fk_vocabularies_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT chk_vocabularies_status CHECK (status IN ('review_needed', 'learned', 'mastered'))
+);
+
+-- Full-text search over the word, meaning and example, weighted in that order
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+ALTER TABLE vocabularies ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', coalesce(word, '')), 'A') ||
+    setweight(to_tsvector('simple', coalesce(meaning, '')), 'B') ||
+    setweight(to_tsvector('simple', coalesce(example, '')), 'C')) STORED;
+
+-- Create review attempts table
+CREATE TABLE review_attempts (
+    id BIGSERIAL PRIMARY KEY,
+    vocabulary_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    grade TEXT NOT NULL,
+    response_time_ms BIGINT,
+    reviewed_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT fk_review_attempts_vocabulary FOREIGN KEY (vocabulary_id) REFERENCES vocabularies(id) ON DELETE CASCADE
+);
+
+-- Create user settings table
+CREATE TABLE user_settings (
+    user_id BIGINT PRIMARY KEY,
+    daily_goal BIGINT NOT NULL,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ
+);
+
+-- Create tags table
+CREATE TABLE tags (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    name TEXT NOT NULL,
+    created_at TIMESTAMPTZ
+);
+
+CREATE TABLE vocabulary_tags (
+    vocabulary_id BIGINT,
+    tag_id BIGINT,
+    PRIMARY KEY (vocabulary_id, tag_id),
+    CONSTRAINT fk_vocabulary_tags_vocabulary FOREIGN KEY (vocabulary_id) REFERENCES vocabularies(id) ON DELETE CASCADE,
+    CONSTRAINT fk_vocabulary_tags_tag FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE
+);
+
+-- Create decks table
+CREATE TABLE decks (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    name TEXT NOT NULL,
+    description TEXT,
+    source_language TEXT,
+    target_language TEXT,
+    new_cards_per_day BIGINT NOT NULL DEFAULT 0,
+    review_cards_per_day BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ
+);
+
+CREATE TABLE vocabulary_decks (
+    vocabulary_id BIGINT,
+    deck_id BIGINT,
+    PRIMARY KEY (vocabulary_id, deck_id),
+    CONSTRAINT fk_vocabulary_decks_vocabulary FOREIGN KEY (vocabulary_id) REFERENCES vocabularies(id) ON DELETE CASCADE,
+    CONSTRAINT fk_vocabulary_decks_deck FOREIGN KEY (deck_id) REFERENCES decks(id) ON DELETE CASCADE
+);
+
+-- Create saved searches table
+CREATE TABLE saved_searches (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    name TEXT NOT NULL,
+    date TEXT,
+    search TEXT,
+    query TEXT,
+    status TEXT,
+    tags TEXT,
+    tag_match TEXT,
+    deck_id BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ
+);
+
+-- Create vocabulary revisions table (edit history)
+CREATE TABLE vocabulary_revisions (
+    id BIGSERIAL PRIMARY KEY,
+    vocabulary_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    source TEXT NOT NULL,
+    changes TEXT,
+    created_at TIMESTAMPTZ,
+    CONSTRAINT fk_vocabulary_revisions_vocabulary FOREIGN KEY (vocabulary_id) REFERENCES vocabularies(id) ON DELETE CASCADE
+);
+
+-- Create status stages table (custom learning stages, in position order)
+CREATE TABLE status_stages (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    name TEXT NOT NULL,
+    status TEXT NOT NULL,
+    position BIGINT NOT NULL,
+    next TEXT,
+    created_at TIMESTAMPTZ,
+    CONSTRAINT chk_status_stages_status CHECK (status IN ('review_needed', 'learned', 'mastered'))
+);
+
+-- Create status transitions table (when each entry moved between stages)
+CREATE TABLE status_transitions (
+    id BIGSERIAL PRIMARY KEY,
+    vocabulary_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    from_stage TEXT NOT NULL DEFAULT '',
+    to_stage TEXT NOT NULL,
+    from_status TEXT NOT NULL DEFAULT '',
+    to_status TEXT NOT NULL,
+    source TEXT NOT NULL,
+    created_at TIMESTAMPTZ,
+    CONSTRAINT fk_status_transitions_vocabulary FOREIGN KEY (vocabulary_id) REFERENCES vocabularies(id) ON DELETE CASCADE
+);
+
+-- Create quiz sessions table (quizzes issued by GenerateQuiz, for grading)
+CREATE TABLE quiz_sessions (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    direction TEXT NOT NULL,
+    created_at TIMESTAMPTZ
+);
+
+CREATE TABLE quiz_questions (
+    id BIGSERIAL PRIMARY KEY,
+    quiz_session_id BIGINT NOT NULL,
+    vocabulary_id BIGINT NOT NULL,
+    answered_at TIMESTAMPTZ,
+    CONSTRAINT fk_quiz_sessions_questions FOREIGN KEY (quiz_session_id) REFERENCES quiz_sessions(id) ON DELETE CASCADE
 );
 
 -- Create indexes for better performance
-CREATE INDEX idx_vocabulary_user_id ON vocabulary(user_id);
-CREATE INDEX idx_vocabulary_date ON vocabulary(date);
-CREATE INDEX idx_vocabulary_user_date ON vocabulary(user_id, date);
-CREATE INDEX idx_vocabulary_word ON vocabulary(word);
-CREATE INDEX idx_users_email ON users(email);
+CREATE INDEX idx_vocabularies_deleted_at ON vocabularies (deleted_at);
+CREATE INDEX idx_vocabularies_next_review_at ON vocabularies (next_review_at);
+CREATE INDEX idx_vocabularies_user_word ON vocabularies (user_id, normalized_word);
+CREATE INDEX idx_vocabularies_user_word_prefix ON vocabularies (user_id, LOWER(word) text_pattern_ops);
+CREATE INDEX idx_vocabularies_search ON vocabularies USING GIN (search_vector);
+CREATE INDEX idx_vocabularies_word_trgm ON vocabularies USING GIN (LOWER(word) gin_trgm_ops);
+CREATE INDEX idx_vocabularies_meaning_trgm ON vocabularies USING GIN (LOWER(meaning) gin_trgm_ops);
+CREATE INDEX idx_vocabularies_example_trgm ON vocabularies USING GIN (LOWER(example) gin_trgm_ops);
+CREATE INDEX idx_review_attempts_user_id ON review_attempts (user_id);
+CREATE INDEX idx_review_attempts_vocabulary_id ON review_attempts (vocabulary_id);
+CREATE INDEX idx_review_attempts_reviewed_at ON review_attempts (reviewed_at);
+CREATE INDEX idx_tags_user_id ON tags (user_id);
+CREATE UNIQUE INDEX idx_tags_user_lower_name ON tags (user_id, LOWER(name));
+CREATE INDEX idx_decks_user_id ON decks (user_id);
+CREATE UNIQUE INDEX idx_decks_user_lower_name ON decks (user_id, LOWER(name));
+CREATE INDEX idx_saved_searches_user_id ON saved_searches (user_id);
+CREATE UNIQUE INDEX idx_saved_searches_user_lower_name ON saved_searches (user_id, LOWER(name));
+CREATE INDEX idx_vocabulary_revisions_vocabulary_id ON vocabulary_revisions (vocabulary_id);
+CREATE UNIQUE INDEX idx_status_stages_user_name ON status_stages (user_id, name);
+CREATE INDEX idx_status_transitions_user_time ON status_transitions (user_id, created_at);
+CREATE INDEX idx_status_transitions_vocabulary_id ON status_transitions (vocabulary_id);
+CREATE INDEX idx_quiz_sessions_user_id ON quiz_sessions (user_id);
+CREATE UNIQUE INDEX idx_quiz_questions_session_vocabulary ON quiz_questions (quiz_session_id, vocabulary_id);
 
 -- Create trigger for updated_at
 CREATE OR REPLACE FUNCTION update_updated_at_column()
@@ -35,5 +205,5 @@ BEGIN
 END;
 $$ language 'plpgsql';
 
-CREATE TRIGGER update_vocabulary_updated_at BEFORE UPDATE ON vocabulary 
-    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
\ No newline at end of file
+CREATE TRIGGER update_vocabulary_updated_at BEFORE UPDATE ON vocabularies
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
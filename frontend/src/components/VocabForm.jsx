@@ -7,6 +7,8 @@ const VocabForm = ({ vocab, onSave, onCancel, selectedDate }) => {
     meaning: vocab?.meaning || '',
     example: vocab?.example || '',
     status: vocab?.status || 'review_needed',
+    // Not edited here, but sent back so saving keeps them: PUT replaces tags
+    tags: vocab?.tags || [],
   });
 
   const [loading, setLoading] = useState(false);
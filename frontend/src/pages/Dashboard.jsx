@@ -110,7 +110,7 @@ const Dashboard = () => {
 
   const handleUpdateStatus = async (id, newStatus) => {
     try {
-      await vocabAPI.updateVocabulary(id, { status: newStatus });
+      await vocabAPI.patchVocabulary(id, { status: newStatus });
       fetchVocabulary();
     } catch (error) {
       console.error('Error updating status:', error);
@@ -45,6 +45,8 @@ export const vocabAPI = {
     api.post('/vocab', data),
   updateVocabulary: (id, data) => 
     api.put(`/vocab/${id}`, data),
+  patchVocabulary: (id, data) => 
+    api.patch(`/vocab/${id}`, data),
   deleteVocabulary: (id) => 
     api.delete(`/vocab/${id}`),
 };
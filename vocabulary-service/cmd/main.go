@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
@@ -14,6 +15,9 @@ import (
 	"google.golang.org/grpc"
 )
 
+// maxMessageSize is the largest request the server accepts
+const maxMessageSize = 64 << 20
+
 func main() {
 	// Load configuration
 	cfg := config.GetConfig()
@@ -28,16 +32,22 @@ func main() {
 		log.Fatal("Failed to run migrations:", err)
 	}
 
+	// Purge entries past the trash retention period in the background
+	go services.RunTrashPurge(context.Background(), cfg.TrashRetentionDays)
+
 	// Create auth interceptor
 	authInterceptor := middleware.NewAuthInterceptor()
 
 	// Create gRPC server with authentication interceptor
 	grpcServer := grpc.NewServer(
 		grpc.UnaryInterceptor(authInterceptor.UnaryInterceptor),
+		grpc.StreamInterceptor(authInterceptor.StreamInterceptor),
+		// Account restores carry the whole archive in a single message
+		grpc.MaxRecvMsgSize(maxMessageSize),
 	)
 
 	// Register vocabulary service
-	vocabService := services.NewVocabularyService()
+	vocabService := services.NewVocabularyService(cfg)
 	proto.RegisterVocabularyServiceServer(grpcServer, vocabService)
 
 	// Start listening on port 50052 (different from auth service)
@@ -2,6 +2,7 @@ package config
 
 import (
 	"os"
+	"strconv"
 )
 
 type Config struct {
@@ -11,6 +12,13 @@ type Config struct {
 	DBName     string
 	DBPort     string
 	JWTSecret  string
+
+	// Daily spaced-repetition limits
+	NewCardsPerDay    int
+	ReviewCardsPerDay int
+
+	// Days deleted entries stay in the trash before they are purged
+	TrashRetentionDays int
 }
 
 func GetConfig() *Config {
@@ -21,6 +29,11 @@ func GetConfig() *Config {
 		DBName:     getEnv("DB_NAME", "vocab_tracker"),
 		DBPort:     getEnv("DB_PORT", "5432"),
 		JWTSecret:  getEnv("JWT_SECRET", "your-secret-key-change-in-production"),
+
+		NewCardsPerDay:    getEnvInt("NEW_CARDS_PER_DAY", 20),
+		ReviewCardsPerDay: getEnvInt("REVIEW_CARDS_PER_DAY", 200),
+
+		TrashRetentionDays: getEnvInt("TRASH_RETENTION_DAYS", 30),
 	}
 }
 
@@ -29,4 +42,13 @@ func getEnv(key, fallback string) string {
 		return value
 	}
 	return fallback
-}
\ No newline at end of file
+}
+
+func getEnvInt(key string, fallback int) int {
+	if value, exists := os.LookupEnv(key); exists {
+		if i, err := strconv.Atoi(value); err == nil {
+			return i
+		}
+	}
+	return fallback
+}
@@ -43,10 +43,106 @@ func Connect() {
 }
 
 func Migrate() error {
-	err := DB.AutoMigrate(&models.Vocabulary{})
+	err := DB.AutoMigrate(&models.Vocabulary{}, &models.ReviewAttempt{}, &models.UserSettings{}, &models.Tag{}, &models.Deck{}, &models.SavedSearch{}, &models.VocabularyRevision{}, &models.StatusStage{}, &models.StatusTransition{}, &models.QuizSession{}, &models.QuizQuestion{})
 	if err != nil {
 		return fmt.Errorf("failed to migrate database: %w", err)
 	}
+
+	// Tag names are unique per user regardless of case
+	err = DB.Exec("CREATE UNIQUE INDEX IF NOT EXISTS idx_tags_user_lower_name ON tags (user_id, LOWER(name))").Error
+	if err != nil {
+		return fmt.Errorf("failed to create tag index: %w", err)
+	}
+
+	// Deck names are unique per user regardless of case
+	err = DB.Exec("CREATE UNIQUE INDEX IF NOT EXISTS idx_decks_user_lower_name ON decks (user_id, LOWER(name))").Error
+	if err != nil {
+		return fmt.Errorf("failed to create deck index: %w", err)
+	}
+
+	// Saved search names are unique per user regardless of case
+	err = DB.Exec("CREATE UNIQUE INDEX IF NOT EXISTS idx_saved_searches_user_lower_name ON saved_searches (user_id, LOWER(name))").Error
+	if err != nil {
+		return fmt.Errorf("failed to create saved search index: %w", err)
+	}
+
+	// Word prefixes for search-as-you-type; text_pattern_ops lets LIKE 'abc%'
+	// use the index whatever the database collation
+	err = DB.Exec("CREATE INDEX IF NOT EXISTS idx_vocabularies_user_word_prefix ON vocabularies (user_id, LOWER(word) text_pattern_ops)").Error
+	if err != nil {
+		return fmt.Errorf("failed to create word prefix index: %w", err)
+	}
+
+	// Full-text search over the word, meaning and example, weighted in that
+	// order, plus trigram indexes for misspellings and partial matches
+	for _, statement := range []string{
+		"CREATE EXTENSION IF NOT EXISTS pg_trgm",
+		`ALTER TABLE vocabularies ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+			setweight(to_tsvector('simple', coalesce(word, '')), 'A') ||
+			setweight(to_tsvector('simple', coalesce(meaning, '')), 'B') ||
+			setweight(to_tsvector('simple', coalesce(example, '')), 'C')) STORED`,
+		"CREATE INDEX IF NOT EXISTS idx_vocabularies_search ON vocabularies USING GIN (search_vector)",
+		"CREATE INDEX IF NOT EXISTS idx_vocabularies_word_trgm ON vocabularies USING GIN (LOWER(word) gin_trgm_ops)",
+		"CREATE INDEX IF NOT EXISTS idx_vocabularies_meaning_trgm ON vocabularies USING GIN (LOWER(meaning) gin_trgm_ops)",
+		"CREATE INDEX IF NOT EXISTS idx_vocabularies_example_trgm ON vocabularies USING GIN (LOWER(example) gin_trgm_ops)",
+	} {
+		if err := DB.Exec(statement).Error; err != nil {
+			return fmt.Errorf("failed to create search index: %w", err)
+		}
+	}
+
+	// Entries created before duplicate detection have no normalised word yet
+	var pending []models.Vocabulary
+	err = DB.Select("id", "word").Where("normalized_word = ''").
+		FindInBatches(&pending, 500, func(tx *gorm.DB, batch int) error {
+			for _, vocab := range pending {
+				if err := DB.Model(&models.Vocabulary{}).Where("id = ?", vocab.ID).
+					UpdateColumn("normalized_word", models.NormalizeWord(vocab.Word)).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		}).Error
+	if err != nil {
+		return fmt.Errorf("failed to backfill normalized words: %w", err)
+	}
+
+	// Entries created before custom stages are at the stage named after
+	// their status
+	err = DB.Model(&models.Vocabulary{}).Unscoped().Where("stage = ''").UpdateColumn("stage", gorm.Expr("status")).Error
+	if err != nil {
+		return fmt.Errorf("failed to backfill stages: %w", err)
+	}
+
+	// Entries created before transitions were recorded get one from their
+	// creation, at their current stage, so the progress timeline counts them
+	err = DB.Exec(`INSERT INTO status_transitions (vocabulary_id, user_id, from_stage, to_stage, from_status, to_status, source, created_at)
+		SELECT v.id, v.user_id, '', v.stage, '', v.status, ?, v.created_at FROM vocabularies v
+		WHERE NOT EXISTS (SELECT 1 FROM status_transitions t WHERE t.vocabulary_id = v.id)`, models.TransitionCreate).Error
+	if err != nil {
+		return fmt.Errorf("failed to backfill transitions: %w", err)
+	}
+
+	// updated_at is set on every change to an entry, including ones made
+	// with UpdateColumn. Created last so the backfills above keep the
+	// updated_at of the entries they fill in on the first migration.
+	for _, statement := range []string{
+		`CREATE OR REPLACE FUNCTION update_updated_at_column()
+		RETURNS TRIGGER AS $$
+		BEGIN
+			NEW.updated_at = CURRENT_TIMESTAMP;
+			RETURN NEW;
+		END;
+		$$ language 'plpgsql'`,
+		"DROP TRIGGER IF EXISTS update_vocabulary_updated_at ON vocabularies",
+		`CREATE TRIGGER update_vocabulary_updated_at BEFORE UPDATE ON vocabularies
+			FOR EACH ROW EXECUTE FUNCTION update_updated_at_column()`,
+	} {
+		if err := DB.Exec(statement).Error; err != nil {
+			return fmt.Errorf("failed to create updated_at trigger: %w", err)
+		}
+	}
+
 	log.Println("Database migration completed")
 	return nil
 }
@@ -138,6 +138,42 @@ func (a *AuthInterceptor) UnaryInterceptor(ctx context.Context, req interface{},
 	return handler(ctx, req)
 }
 
+// StreamInterceptor validates JWT tokens for streaming RPC calls
+func (a *AuthInterceptor) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	// Skip authentication for health checks or other public methods
+	if isPublicMethod(info.FullMethod) {
+		return handler(srv, ss)
+	}
+
+	// Extract token from metadata
+	token, err := extractTokenFromMetadata(ss.Context())
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "missing or invalid token: %v", err)
+	}
+
+	// Validate token locally
+	userID, email, err := ValidateToken(token)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+	}
+
+	// Add user info to the stream's context
+	ctx := context.WithValue(ss.Context(), "userID", uint32(userID))
+	ctx = context.WithValue(ctx, "email", email)
+
+	return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+}
+
+// authenticatedStream is a server stream carrying the authenticated user in its context
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
 // extractTokenFromMetadata extracts JWT token from gRPC metadata
 func extractTokenFromMetadata(ctx context.Context) (string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+)
+
+// Deck is a named collection of vocabulary entries with its own language
+// pair and study settings. An entry can belong to any number of decks.
+type Deck struct {
+	ID             uint   `json:"id" gorm:"primaryKey"`
+	UserID         uint   `json:"user_id" gorm:"not null;index"`
+	Name           string `json:"name" gorm:"not null"`
+	Description    string `json:"description"`
+	SourceLanguage string `json:"source_language"`
+	TargetLanguage string `json:"target_language"`
+
+	// Daily study limits for this deck; zero uses the server setting
+	NewCardsPerDay    int `json:"new_cards_per_day" gorm:"not null;default:0"`
+	ReviewCardsPerDay int `json:"review_cards_per_day" gorm:"not null;default:0"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
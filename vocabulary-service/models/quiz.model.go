@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+)
+
+// QuizSession is a quiz issued by GenerateQuiz. Only its questions can be
+// graded, each once, in the direction it was generated with.
+type QuizSession struct {
+	ID        uint           `json:"id" gorm:"primaryKey"`
+	UserID    uint           `json:"user_id" gorm:"not null;index"`
+	Direction string         `json:"direction" gorm:"not null"`
+	Questions []QuizQuestion `json:"questions" gorm:"constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+// QuizQuestion is one question of a quiz session, asking about a vocabulary
+// entry. AnsweredAt is set once the question has been graded.
+type QuizQuestion struct {
+	ID            uint       `json:"id" gorm:"primaryKey"`
+	QuizSessionID uint       `json:"quiz_session_id" gorm:"not null;uniqueIndex:idx_quiz_questions_session_vocabulary,priority:1"`
+	VocabularyID  uint       `json:"vocabulary_id" gorm:"not null;uniqueIndex:idx_quiz_questions_session_vocabulary,priority:2"`
+	AnsweredAt    *time.Time `json:"answered_at"`
+}
@@ -0,0 +1,16 @@
+package models
+
+import (
+	"time"
+)
+
+// ReviewAttempt records a single graded review of a vocabulary entry
+type ReviewAttempt struct {
+	ID             uint       `json:"id" gorm:"primaryKey"`
+	VocabularyID   uint       `json:"vocabulary_id" gorm:"not null;index"`
+	UserID         uint       `json:"user_id" gorm:"not null;index"`
+	Grade          string     `json:"grade" gorm:"not null"`
+	ResponseTimeMs int        `json:"response_time_ms"`
+	ReviewedAt     time.Time  `json:"reviewed_at" gorm:"not null;index"`
+	Vocabulary     Vocabulary `json:"-" gorm:"constraint:OnDelete:CASCADE"`
+}
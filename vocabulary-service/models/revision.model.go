@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+)
+
+// Sources of a vocabulary revision
+const (
+	RevisionUpdate     = "update"
+	RevisionBulkUpdate = "bulk_update"
+	RevisionMerge      = "merge"
+	RevisionRevert     = "revert"
+	RevisionReview     = "review"
+	RevisionQuiz       = "quiz"
+)
+
+// VocabularyRevision records one change to a vocabulary entry's word,
+// meaning, example or status, with the old and new value of each field it
+// changed
+type VocabularyRevision struct {
+	ID           uint          `json:"id" gorm:"primaryKey"`
+	VocabularyID uint          `json:"vocabulary_id" gorm:"not null;index"`
+	UserID       uint          `json:"user_id" gorm:"not null"`
+	Source       string        `json:"source" gorm:"not null"`
+	Changes      []FieldChange `json:"changes" gorm:"type:text;serializer:json"`
+	CreatedAt    time.Time     `json:"created_at"`
+	Vocabulary   Vocabulary    `json:"-" gorm:"constraint:OnDelete:CASCADE"`
+}
+
+// FieldChange is the old and new value of a field changed by a revision
+type FieldChange struct {
+	Field    string `json:"field"`
+	OldValue string `json:"old_value"`
+	NewValue string `json:"new_value"`
+}
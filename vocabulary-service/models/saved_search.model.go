@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+)
+
+// SavedSearch is a named set of vocabulary filters. Its entries are looked
+// up each time it is listed, so they follow changes to the vocabulary.
+type SavedSearch struct {
+	ID     uint   `json:"id" gorm:"primaryKey"`
+	UserID uint   `json:"user_id" gorm:"not null;index"`
+	Name   string `json:"name" gorm:"not null"`
+
+	// The filters, as in GetVocabularies
+	Date     string   `json:"date"`
+	Search   string   `json:"search"`
+	Query    string   `json:"query"`
+	Status   string   `json:"status"`
+	Tags     []string `json:"tags" gorm:"type:text;serializer:json"`
+	TagMatch string   `json:"tag_match"`
+	DeckID   uint     `json:"deck_id" gorm:"not null;default:0"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
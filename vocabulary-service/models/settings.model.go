@@ -0,0 +1,13 @@
+package models
+
+import (
+	"time"
+)
+
+// UserSettings holds per-user learning preferences
+type UserSettings struct {
+	UserID    uint      `json:"user_id" gorm:"primaryKey;autoIncrement:false"`
+	DailyGoal int       `json:"daily_goal" gorm:"not null"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
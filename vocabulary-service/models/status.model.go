@@ -0,0 +1,44 @@
+package models
+
+import (
+	"time"
+)
+
+// Sources of a status transition that are not also sources of a revision
+const (
+	TransitionCreate = "create"
+	TransitionStages = "stages"
+)
+
+// StatusStage is one of a user's custom learning stages, e.g. "familiar".
+// Each stage counts as one of the built-in statuses, so that reviews, stats
+// and filters work the same with or without custom stages.
+type StatusStage struct {
+	ID       uint   `json:"id" gorm:"primaryKey"`
+	UserID   uint   `json:"user_id" gorm:"not null;uniqueIndex:idx_status_stages_user_name,priority:1"`
+	Name     string `json:"name" gorm:"not null;uniqueIndex:idx_status_stages_user_name,priority:2"`
+	Status   string `json:"status" gorm:"not null;check:chk_status_stages_status,status IN ('review_needed', 'learned', 'mastered')"`
+	Position int    `json:"position" gorm:"not null"`
+
+	// Next are the names of the stages an entry can move to from this one
+	Next []string `json:"next" gorm:"type:text;serializer:json"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// StatusTransition records a move of a vocabulary entry from one stage to
+// another, and when it happened. Entries are created with a transition from
+// no stage. A change of stages that changes only an entry's status is
+// recorded with the same from and to stage.
+type StatusTransition struct {
+	ID           uint       `json:"id" gorm:"primaryKey"`
+	VocabularyID uint       `json:"vocabulary_id" gorm:"not null;index"`
+	UserID       uint       `json:"user_id" gorm:"not null;index:idx_status_transitions_user_time,priority:1"`
+	FromStage    string     `json:"from_stage" gorm:"not null;default:''"`
+	ToStage      string     `json:"to_stage" gorm:"not null"`
+	FromStatus   string     `json:"from_status" gorm:"not null;default:''"`
+	ToStatus     string     `json:"to_status" gorm:"not null"`
+	Source       string     `json:"source" gorm:"not null"`
+	CreatedAt    time.Time  `json:"created_at" gorm:"index:idx_status_transitions_user_time,priority:2"`
+	Vocabulary   Vocabulary `json:"-" gorm:"constraint:OnDelete:CASCADE"`
+}
@@ -0,0 +1,14 @@
+package models
+
+import (
+	"time"
+)
+
+// Tag groups a user's vocabulary entries, e.g. "TOEFL" or "chapter-3".
+// Names are unique per user, ignoring case.
+type Tag struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	UserID    uint      `json:"user_id" gorm:"not null;index"`
+	Name      string    `json:"name" gorm:"not null"`
+	CreatedAt time.Time `json:"created_at"`
+}
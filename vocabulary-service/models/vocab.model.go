@@ -1,7 +1,11 @@
 package models
 
 import (
+	"strings"
 	"time"
+
+	"golang.org/x/text/unicode/norm"
+	"gorm.io/gorm"
 )
 
 type User struct {
@@ -11,17 +15,50 @@ type User struct {
 	CreatedAt    time.Time `json:"created_at"`
 }
 
+// Vocabulary status values
+const (
+	StatusReviewNeeded = "review_needed"
+	StatusLearned      = "learned"
+	StatusMastered     = "mastered"
+)
+
 type Vocabulary struct {
 	ID        uint      `json:"id" gorm:"primaryKey"`
-	UserID    uint      `json:"user_id" gorm:"not null"`
+	UserID    uint      `json:"user_id" gorm:"not null;index:idx_vocabularies_user_word,priority:1"`
 	Word      string    `json:"word" gorm:"not null"`
 	Meaning   string    `json:"meaning" gorm:"not null"`
 	Example   string    `json:"example"`
 	Date      time.Time `json:"date" gorm:"type:date;not null"`
-	Status    string    `json:"status" gorm:"default:'review_needed'"`
+	Status    string    `json:"status" gorm:"not null;default:'review_needed';check:chk_vocabularies_status,status IN ('review_needed', 'learned', 'mastered')"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
-	User      User      `json:"user" gorm:"foreignKey:UserID"`
+	User      User      `json:"user" gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+
+	// Stage is the user's learning stage of the entry, one of their custom
+	// stages or, without them, the status itself. The stage decides the status.
+	Stage string `json:"stage" gorm:"not null;default:''"`
+
+	// NormalizedWord is Word as compared when detecting duplicates
+	NormalizedWord string `json:"-" gorm:"not null;default:'';index:idx_vocabularies_user_word,priority:2"`
+
+	// Spaced-repetition (SM-2) scheduling state
+	EaseFactor      float64    `json:"ease_factor" gorm:"not null;default:2.5"`
+	IntervalDays    int        `json:"interval_days" gorm:"not null;default:0"`
+	Repetitions     int        `json:"repetitions" gorm:"not null;default:0"`
+	NextReviewAt    *time.Time `json:"next_review_at" gorm:"index"`
+	LastReviewedAt  *time.Time `json:"last_reviewed_at"`
+	FirstReviewedAt *time.Time `json:"first_reviewed_at"`
+
+	// Version is increased by every change to the entry, so an update
+	// made from a stale copy can be detected
+	Version int `json:"version" gorm:"not null;default:1"`
+
+	// DeletedAt is set while the entry is in the trash. GORM leaves trashed
+	// entries out of every query unless it is Unscoped.
+	DeletedAt gorm.DeletedAt `json:"deleted_at" gorm:"index"`
+
+	Tags  []Tag  `json:"tags" gorm:"many2many:vocabulary_tags;constraint:OnDelete:CASCADE"`
+	Decks []Deck `json:"decks" gorm:"many2many:vocabulary_decks;constraint:OnDelete:CASCADE"`
 }
 
 type VocabRequest struct {
@@ -37,4 +74,11 @@ type VocabUpdateRequest struct {
 	Meaning string `json:"meaning"`
 	Example string `json:"example"`
 	Status  string `json:"status"`
-}
\ No newline at end of file
+}
+
+// NormalizeWord returns the form of a word used to detect duplicates: its
+// whitespace trimmed and collapsed, lower-cased and Unicode-normalised, so
+// that "Café", "cafe\u0301 " and "CAFÉ" compare equal.
+func NormalizeWord(word string) string {
+	return norm.NFKC.String(strings.ToLower(norm.NFKC.String(strings.Join(strings.Fields(word), " "))))
+}
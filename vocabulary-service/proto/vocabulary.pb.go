@@ -9,6 +9,7 @@ package proto
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,14 +22,75 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// The built-in statuses. Every stage, built-in or custom, has one of them.
+type VocabularyStatus int32
+
+const (
+	VocabularyStatus_VOCABULARY_STATUS_UNSPECIFIED   VocabularyStatus = 0
+	VocabularyStatus_VOCABULARY_STATUS_REVIEW_NEEDED VocabularyStatus = 1
+	VocabularyStatus_VOCABULARY_STATUS_LEARNED       VocabularyStatus = 2
+	VocabularyStatus_VOCABULARY_STATUS_MASTERED      VocabularyStatus = 3
+)
+
+// Enum value maps for VocabularyStatus.
+var (
+	VocabularyStatus_name = map[int32]string{
+		0: "VOCABULARY_STATUS_UNSPECIFIED",
+		1: "VOCABULARY_STATUS_REVIEW_NEEDED",
+		2: "VOCABULARY_STATUS_LEARNED",
+		3: "VOCABULARY_STATUS_MASTERED",
+	}
+	VocabularyStatus_value = map[string]int32{
+		"VOCABULARY_STATUS_UNSPECIFIED":   0,
+		"VOCABULARY_STATUS_REVIEW_NEEDED": 1,
+		"VOCABULARY_STATUS_LEARNED":       2,
+		"VOCABULARY_STATUS_MASTERED":      3,
+	}
+)
+
+func (x VocabularyStatus) Enum() *VocabularyStatus {
+	p := new(VocabularyStatus)
+	*p = x
+	return p
+}
+
+func (x VocabularyStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VocabularyStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_vocabulary_proto_enumTypes[0].Descriptor()
+}
+
+func (VocabularyStatus) Type() protoreflect.EnumType {
+	return &file_proto_vocabulary_proto_enumTypes[0]
+}
+
+func (x VocabularyStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VocabularyStatus.Descriptor instead.
+func (VocabularyStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{0}
+}
+
 // Request messages
 type GetVocabulariesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`      // Optional: filter by date (YYYY-MM-DD)
-	Search        string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`  // Optional: search term
-	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`   // Optional: limit results
-	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"` // Optional: pagination offset
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`                             // Optional: filter by date (YYYY-MM-DD)
+	Search        string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`                         // Optional: full-text search, tolerating typos; results are ranked by relevance
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                          // Optional: limit results
+	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`                        // Optional: pagination offset
+	Tags          []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`                             // Optional: filter by tag names (case-insensitive)
+	TagMatch      string                 `protobuf:"bytes,7,opt,name=tag_match,json=tagMatch,proto3" json:"tag_match,omitempty"`     // "any" (default) or "all" of the tags
+	DeckId        uint32                 `protobuf:"varint,8,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`          // Optional: only entries in this deck
+	Status        string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`                         // Optional: filter by status or stage
+	Q             string                 `protobuf:"bytes,10,opt,name=q,proto3" json:"q,omitempty"`                                  // Optional: filter query, e.g. "status:mastered tag:gre date>=2024-01-01 -example:empty word:abs*"
+	SortBy        string                 `protobuf:"bytes,11,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`          // "created_at" (default), "updated_at", "date", "word", "status", "next_review_at" or "relevance" (default when searching)
+	SortOrder     string                 `protobuf:"bytes,12,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"` // "asc" or "desc"; defaults to desc for created_at, updated_at and date, asc otherwise
+	PageToken     string                 `protobuf:"bytes,13,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Optional: next_page_token of the previous page; offset is ignored when set
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -98,14 +160,73 @@ func (x *GetVocabulariesRequest) GetOffset() int32 {
 	return 0
 }
 
+func (x *GetVocabulariesRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *GetVocabulariesRequest) GetTagMatch() string {
+	if x != nil {
+		return x.TagMatch
+	}
+	return ""
+}
+
+func (x *GetVocabulariesRequest) GetDeckId() uint32 {
+	if x != nil {
+		return x.DeckId
+	}
+	return 0
+}
+
+func (x *GetVocabulariesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetVocabulariesRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *GetVocabulariesRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *GetVocabulariesRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+func (x *GetVocabulariesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type CreateVocabularyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Word          string                 `protobuf:"bytes,2,opt,name=word,proto3" json:"word,omitempty"`
 	Meaning       string                 `protobuf:"bytes,3,opt,name=meaning,proto3" json:"meaning,omitempty"`
 	Example       string                 `protobuf:"bytes,4,opt,name=example,proto3" json:"example,omitempty"`
-	Date          string                 `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`     // YYYY-MM-DD format
-	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // Optional: defaults to "review_needed"
+	Date          string                 `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`                                  // YYYY-MM-DD format
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                              // Optional: the stage to start at; defaults to the first stage, "review_needed" without custom stages
+	Tags          []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`                                  // Optional: tag names, created if they do not exist
+	DeckIds       []uint32               `protobuf:"varint,8,rep,packed,name=deck_ids,json=deckIds,proto3" json:"deck_ids,omitempty"`     // Optional: decks to add the entry to
+	OnDuplicate   string                 `protobuf:"bytes,9,opt,name=on_duplicate,json=onDuplicate,proto3" json:"on_duplicate,omitempty"` // "reject" (default) or "merge" into the existing entry
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -182,14 +303,43 @@ func (x *CreateVocabularyRequest) GetStatus() string {
 	return ""
 }
 
+func (x *CreateVocabularyRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateVocabularyRequest) GetDeckIds() []uint32 {
+	if x != nil {
+		return x.DeckIds
+	}
+	return nil
+}
+
+func (x *CreateVocabularyRequest) GetOnDuplicate() string {
+	if x != nil {
+		return x.OnDuplicate
+	}
+	return ""
+}
+
 type UpdateVocabularyRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	VocabularyId  uint32                 `protobuf:"varint,1,opt,name=vocabulary_id,json=vocabularyId,proto3" json:"vocabulary_id,omitempty"`
-	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Word          string                 `protobuf:"bytes,3,opt,name=word,proto3" json:"word,omitempty"`
-	Meaning       string                 `protobuf:"bytes,4,opt,name=meaning,proto3" json:"meaning,omitempty"`
-	Example       string                 `protobuf:"bytes,5,opt,name=example,proto3" json:"example,omitempty"`
-	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	VocabularyId    uint32                 `protobuf:"varint,1,opt,name=vocabulary_id,json=vocabularyId,proto3" json:"vocabulary_id,omitempty"`
+	UserId          uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Word            string                 `protobuf:"bytes,3,opt,name=word,proto3" json:"word,omitempty"`
+	Meaning         string                 `protobuf:"bytes,4,opt,name=meaning,proto3" json:"meaning,omitempty"`
+	Example         string                 `protobuf:"bytes,5,opt,name=example,proto3" json:"example,omitempty"`
+	Status          string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                                           // The stage to move to; it must be reachable from the current stage
+	Tags            []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`                                               // Optional: replaces the entry's tags
+	ClearTags       bool                   `protobuf:"varint,8,opt,name=clear_tags,json=clearTags,proto3" json:"clear_tags,omitempty"`                   // Remove all tags from the entry
+	ExpectedVersion int32                  `protobuf:"varint,9,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // Optional: fail with conflict unless the entry is at this version
+	// Optional: the fields to set, among word, meaning, example, status and
+	// tags. Fields in the mask are set even when empty, which clears example
+	// and tags; fields outside it are left unchanged. Without a mask, empty
+	// fields are left unchanged.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,10,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -266,6 +416,34 @@ func (x *UpdateVocabularyRequest) GetStatus() string {
 	return ""
 }
 
+func (x *UpdateVocabularyRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateVocabularyRequest) GetClearTags() bool {
+	if x != nil {
+		return x.ClearTags
+	}
+	return false
+}
+
+func (x *UpdateVocabularyRequest) GetExpectedVersion() int32 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *UpdateVocabularyRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
 type DeleteVocabularyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	VocabularyId  uint32                 `protobuf:"varint,1,opt,name=vocabulary_id,json=vocabularyId,proto3" json:"vocabulary_id,omitempty"`
@@ -370,29 +548,30 @@ func (x *GetVocabularyByIdRequest) GetUserId() uint32 {
 	return 0
 }
 
-type GetVocabularyStatsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	DateFrom      string                 `protobuf:"bytes,2,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"` // Optional: start date for stats
-	DateTo        string                 `protobuf:"bytes,3,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`       // Optional: end date for stats
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ReviewVocabularyRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	VocabularyId   uint32                 `protobuf:"varint,1,opt,name=vocabulary_id,json=vocabularyId,proto3" json:"vocabulary_id,omitempty"`
+	UserId         uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Grade          string                 `protobuf:"bytes,3,opt,name=grade,proto3" json:"grade,omitempty"`                                            // "again", "hard", "good" or "easy"
+	ResponseTimeMs int32                  `protobuf:"varint,4,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"` // Optional: time taken to answer
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetVocabularyStatsRequest) Reset() {
-	*x = GetVocabularyStatsRequest{}
+func (x *ReviewVocabularyRequest) Reset() {
+	*x = ReviewVocabularyRequest{}
 	mi := &file_proto_vocabulary_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVocabularyStatsRequest) String() string {
+func (x *ReviewVocabularyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVocabularyStatsRequest) ProtoMessage() {}
+func (*ReviewVocabularyRequest) ProtoMessage() {}
 
-func (x *GetVocabularyStatsRequest) ProtoReflect() protoreflect.Message {
+func (x *ReviewVocabularyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_vocabulary_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -404,58 +583,63 @@ func (x *GetVocabularyStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVocabularyStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetVocabularyStatsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReviewVocabularyRequest.ProtoReflect.Descriptor instead.
+func (*ReviewVocabularyRequest) Descriptor() ([]byte, []int) {
 	return file_proto_vocabulary_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetVocabularyStatsRequest) GetUserId() uint32 {
+func (x *ReviewVocabularyRequest) GetVocabularyId() uint32 {
+	if x != nil {
+		return x.VocabularyId
+	}
+	return 0
+}
+
+func (x *ReviewVocabularyRequest) GetUserId() uint32 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *GetVocabularyStatsRequest) GetDateFrom() string {
+func (x *ReviewVocabularyRequest) GetGrade() string {
 	if x != nil {
-		return x.DateFrom
+		return x.Grade
 	}
 	return ""
 }
 
-func (x *GetVocabularyStatsRequest) GetDateTo() string {
+func (x *ReviewVocabularyRequest) GetResponseTimeMs() int32 {
 	if x != nil {
-		return x.DateTo
+		return x.ResponseTimeMs
 	}
-	return ""
+	return 0
 }
 
-// Response messages
-type GetVocabulariesResponse struct {
+type GetDueVocabulariesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Vocabularies  []*Vocabulary          `protobuf:"bytes,3,rep,name=vocabularies,proto3" json:"vocabularies,omitempty"`
-	Count         int32                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
-	Total         int32                  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"` // Total count (for pagination)
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NewLimit      int32                  `protobuf:"varint,2,opt,name=new_limit,json=newLimit,proto3" json:"new_limit,omitempty"`          // Optional: new cards per day (defaults to server setting)
+	ReviewLimit   int32                  `protobuf:"varint,3,opt,name=review_limit,json=reviewLimit,proto3" json:"review_limit,omitempty"` // Optional: review cards per day (defaults to server setting)
+	DeckId        uint32                 `protobuf:"varint,4,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`                // Optional: only study this deck, using its study settings
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVocabulariesResponse) Reset() {
-	*x = GetVocabulariesResponse{}
+func (x *GetDueVocabulariesRequest) Reset() {
+	*x = GetDueVocabulariesRequest{}
 	mi := &file_proto_vocabulary_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVocabulariesResponse) String() string {
+func (x *GetDueVocabulariesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVocabulariesResponse) ProtoMessage() {}
+func (*GetDueVocabulariesRequest) ProtoMessage() {}
 
-func (x *GetVocabulariesResponse) ProtoReflect() protoreflect.Message {
+func (x *GetDueVocabulariesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_vocabulary_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -467,69 +651,62 @@ func (x *GetVocabulariesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVocabulariesResponse.ProtoReflect.Descriptor instead.
-func (*GetVocabulariesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDueVocabulariesRequest.ProtoReflect.Descriptor instead.
+func (*GetDueVocabulariesRequest) Descriptor() ([]byte, []int) {
 	return file_proto_vocabulary_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetVocabulariesResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *GetVocabulariesResponse) GetMessage() string {
+func (x *GetDueVocabulariesRequest) GetUserId() uint32 {
 	if x != nil {
-		return x.Message
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetVocabulariesResponse) GetVocabularies() []*Vocabulary {
+func (x *GetDueVocabulariesRequest) GetNewLimit() int32 {
 	if x != nil {
-		return x.Vocabularies
+		return x.NewLimit
 	}
-	return nil
+	return 0
 }
 
-func (x *GetVocabulariesResponse) GetCount() int32 {
+func (x *GetDueVocabulariesRequest) GetReviewLimit() int32 {
 	if x != nil {
-		return x.Count
+		return x.ReviewLimit
 	}
 	return 0
 }
 
-func (x *GetVocabulariesResponse) GetTotal() int32 {
+func (x *GetDueVocabulariesRequest) GetDeckId() uint32 {
 	if x != nil {
-		return x.Total
+		return x.DeckId
 	}
 	return 0
 }
 
-type VocabularyResponse struct {
+type GetReviewHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Vocabulary    *Vocabulary            `protobuf:"bytes,3,opt,name=vocabulary,proto3" json:"vocabulary,omitempty"`
+	VocabularyId  uint32                 `protobuf:"varint,1,opt,name=vocabulary_id,json=vocabularyId,proto3" json:"vocabulary_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"` // Optional: limit results
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VocabularyResponse) Reset() {
-	*x = VocabularyResponse{}
+func (x *GetReviewHistoryRequest) Reset() {
+	*x = GetReviewHistoryRequest{}
 	mi := &file_proto_vocabulary_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VocabularyResponse) String() string {
+func (x *GetReviewHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VocabularyResponse) ProtoMessage() {}
+func (*GetReviewHistoryRequest) ProtoMessage() {}
 
-func (x *VocabularyResponse) ProtoReflect() protoreflect.Message {
+func (x *GetReviewHistoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_vocabulary_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -541,54 +718,59 @@ func (x *VocabularyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VocabularyResponse.ProtoReflect.Descriptor instead.
-func (*VocabularyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetReviewHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetReviewHistoryRequest) Descriptor() ([]byte, []int) {
 	return file_proto_vocabulary_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *VocabularyResponse) GetSuccess() bool {
+func (x *GetReviewHistoryRequest) GetVocabularyId() uint32 {
 	if x != nil {
-		return x.Success
+		return x.VocabularyId
 	}
-	return false
+	return 0
 }
 
-func (x *VocabularyResponse) GetMessage() string {
+func (x *GetReviewHistoryRequest) GetUserId() uint32 {
 	if x != nil {
-		return x.Message
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *VocabularyResponse) GetVocabulary() *Vocabulary {
+func (x *GetReviewHistoryRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Vocabulary
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-type DeleteVocabularyResponse struct {
+type GenerateQuizRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	QuestionCount int32                  `protobuf:"varint,2,opt,name=question_count,json=questionCount,proto3" json:"question_count,omitempty"` // Optional: defaults to 10
+	Direction     string                 `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"`                               // "word_to_meaning" (default) or "meaning_to_word"
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                                     // Optional: only quiz entries with this status or stage
+	DateFrom      string                 `protobuf:"bytes,5,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`                 // Optional: YYYY-MM-DD
+	DateTo        string                 `protobuf:"bytes,6,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`                       // Optional: YYYY-MM-DD
+	ChoiceCount   int32                  `protobuf:"varint,7,opt,name=choice_count,json=choiceCount,proto3" json:"choice_count,omitempty"`       // Optional: options per question, defaults to 4
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteVocabularyResponse) Reset() {
-	*x = DeleteVocabularyResponse{}
+func (x *GenerateQuizRequest) Reset() {
+	*x = GenerateQuizRequest{}
 	mi := &file_proto_vocabulary_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteVocabularyResponse) String() string {
+func (x *GenerateQuizRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteVocabularyResponse) ProtoMessage() {}
+func (*GenerateQuizRequest) ProtoMessage() {}
 
-func (x *DeleteVocabularyResponse) ProtoReflect() protoreflect.Message {
+func (x *GenerateQuizRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_vocabulary_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -600,52 +782,84 @@ func (x *DeleteVocabularyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteVocabularyResponse.ProtoReflect.Descriptor instead.
-func (*DeleteVocabularyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GenerateQuizRequest.ProtoReflect.Descriptor instead.
+func (*GenerateQuizRequest) Descriptor() ([]byte, []int) {
 	return file_proto_vocabulary_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DeleteVocabularyResponse) GetSuccess() bool {
+func (x *GenerateQuizRequest) GetUserId() uint32 {
 	if x != nil {
-		return x.Success
+		return x.UserId
 	}
-	return false
+	return 0
 }
 
-func (x *DeleteVocabularyResponse) GetMessage() string {
+func (x *GenerateQuizRequest) GetQuestionCount() int32 {
 	if x != nil {
-		return x.Message
+		return x.QuestionCount
+	}
+	return 0
+}
+
+func (x *GenerateQuizRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
 	}
 	return ""
 }
 
-type VocabularyStatsResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	TotalWords     int32                  `protobuf:"varint,3,opt,name=total_words,json=totalWords,proto3" json:"total_words,omitempty"`
-	WordsThisWeek  int32                  `protobuf:"varint,4,opt,name=words_this_week,json=wordsThisWeek,proto3" json:"words_this_week,omitempty"`
-	WordsThisMonth int32                  `protobuf:"varint,5,opt,name=words_this_month,json=wordsThisMonth,proto3" json:"words_this_month,omitempty"`
-	StatusCounts   map[string]int32       `protobuf:"bytes,6,rep,name=status_counts,json=statusCounts,proto3" json:"status_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Count by status
-	DailyCounts    []*DailyCount          `protobuf:"bytes,7,rep,name=daily_counts,json=dailyCounts,proto3" json:"daily_counts,omitempty"`                                                                               // Daily word counts
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GenerateQuizRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *VocabularyStatsResponse) Reset() {
-	*x = VocabularyStatsResponse{}
+func (x *GenerateQuizRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *GenerateQuizRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *GenerateQuizRequest) GetChoiceCount() int32 {
+	if x != nil {
+		return x.ChoiceCount
+	}
+	return 0
+}
+
+type SubmitQuizRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Direction     string                 `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"` // Optional: must match the generated quiz if set
+	Answers       []*QuizAnswer          `protobuf:"bytes,3,rep,name=answers,proto3" json:"answers,omitempty"`
+	QuizId        uint32                 `protobuf:"varint,4,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"` // The quiz returned by GenerateQuiz; each of its questions can be answered once
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitQuizRequest) Reset() {
+	*x = SubmitQuizRequest{}
 	mi := &file_proto_vocabulary_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VocabularyStatsResponse) String() string {
+func (x *SubmitQuizRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VocabularyStatsResponse) ProtoMessage() {}
+func (*SubmitQuizRequest) ProtoMessage() {}
 
-func (x *VocabularyStatsResponse) ProtoReflect() protoreflect.Message {
+func (x *SubmitQuizRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_vocabulary_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -657,91 +871,6650 @@ func (x *VocabularyStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VocabularyStatsResponse.ProtoReflect.Descriptor instead.
-func (*VocabularyStatsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubmitQuizRequest.ProtoReflect.Descriptor instead.
+func (*SubmitQuizRequest) Descriptor() ([]byte, []int) {
 	return file_proto_vocabulary_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *VocabularyStatsResponse) GetSuccess() bool {
+func (x *SubmitQuizRequest) GetUserId() uint32 {
 	if x != nil {
-		return x.Success
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SubmitQuizRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *SubmitQuizRequest) GetAnswers() []*QuizAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *SubmitQuizRequest) GetQuizId() uint32 {
+	if x != nil {
+		return x.QuizId
+	}
+	return 0
+}
+
+type GenerateClozeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`  // Optional: defaults to 10
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // Optional: only use entries with this status or stage
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateClozeRequest) Reset() {
+	*x = GenerateClozeRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateClozeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateClozeRequest) ProtoMessage() {}
+
+func (x *GenerateClozeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateClozeRequest.ProtoReflect.Descriptor instead.
+func (*GenerateClozeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GenerateClozeRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GenerateClozeRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GenerateClozeRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GradeClozeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Answers       []*ClozeAnswer         `protobuf:"bytes,2,rep,name=answers,proto3" json:"answers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeClozeRequest) Reset() {
+	*x = GradeClozeRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeClozeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeClozeRequest) ProtoMessage() {}
+
+func (x *GradeClozeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeClozeRequest.ProtoReflect.Descriptor instead.
+func (*GradeClozeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GradeClozeRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GradeClozeRequest) GetAnswers() []*ClozeAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+type SetDailyGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DailyGoal     int32                  `protobuf:"varint,2,opt,name=daily_goal,json=dailyGoal,proto3" json:"daily_goal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDailyGoalRequest) Reset() {
+	*x = SetDailyGoalRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDailyGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDailyGoalRequest) ProtoMessage() {}
+
+func (x *SetDailyGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDailyGoalRequest.ProtoReflect.Descriptor instead.
+func (*SetDailyGoalRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetDailyGoalRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetDailyGoalRequest) GetDailyGoal() int32 {
+	if x != nil {
+		return x.DailyGoal
+	}
+	return 0
+}
+
+type ListTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListTagsRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type RenameTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TagId         uint32                 `protobuf:"varint,2,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTagRequest) Reset() {
+	*x = RenameTagRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagRequest) ProtoMessage() {}
+
+func (x *RenameTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagRequest.ProtoReflect.Descriptor instead.
+func (*RenameTagRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RenameTagRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RenameTagRequest) GetTagId() uint32 {
+	if x != nil {
+		return x.TagId
+	}
+	return 0
+}
+
+func (x *RenameTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TagId         uint32                 `protobuf:"varint,2,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagRequest) Reset() {
+	*x = DeleteTagRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagRequest) ProtoMessage() {}
+
+func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteTagRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteTagRequest) GetTagId() uint32 {
+	if x != nil {
+		return x.TagId
+	}
+	return 0
+}
+
+type CreateDeckRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	SourceLanguage    string                 `protobuf:"bytes,4,opt,name=source_language,json=sourceLanguage,proto3" json:"source_language,omitempty"`               // Optional: language of the words, e.g. "en"
+	TargetLanguage    string                 `protobuf:"bytes,5,opt,name=target_language,json=targetLanguage,proto3" json:"target_language,omitempty"`               // Optional: language of the meanings, e.g. "es"
+	NewCardsPerDay    int32                  `protobuf:"varint,6,opt,name=new_cards_per_day,json=newCardsPerDay,proto3" json:"new_cards_per_day,omitempty"`          // Optional: 0 uses the server setting
+	ReviewCardsPerDay int32                  `protobuf:"varint,7,opt,name=review_cards_per_day,json=reviewCardsPerDay,proto3" json:"review_cards_per_day,omitempty"` // Optional: 0 uses the server setting
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateDeckRequest) Reset() {
+	*x = CreateDeckRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDeckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDeckRequest) ProtoMessage() {}
+
+func (x *CreateDeckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDeckRequest.ProtoReflect.Descriptor instead.
+func (*CreateDeckRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateDeckRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateDeckRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateDeckRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateDeckRequest) GetSourceLanguage() string {
+	if x != nil {
+		return x.SourceLanguage
+	}
+	return ""
+}
+
+func (x *CreateDeckRequest) GetTargetLanguage() string {
+	if x != nil {
+		return x.TargetLanguage
+	}
+	return ""
+}
+
+func (x *CreateDeckRequest) GetNewCardsPerDay() int32 {
+	if x != nil {
+		return x.NewCardsPerDay
+	}
+	return 0
+}
+
+func (x *CreateDeckRequest) GetReviewCardsPerDay() int32 {
+	if x != nil {
+		return x.ReviewCardsPerDay
+	}
+	return 0
+}
+
+type GetDecksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDecksRequest) Reset() {
+	*x = GetDecksRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDecksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDecksRequest) ProtoMessage() {}
+
+func (x *GetDecksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDecksRequest.ProtoReflect.Descriptor instead.
+func (*GetDecksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetDecksRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type DeckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeckId        uint32                 `protobuf:"varint,1,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeckRequest) Reset() {
+	*x = DeckRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeckRequest) ProtoMessage() {}
+
+func (x *DeckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeckRequest.ProtoReflect.Descriptor instead.
+func (*DeckRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeckRequest) GetDeckId() uint32 {
+	if x != nil {
+		return x.DeckId
+	}
+	return 0
+}
+
+func (x *DeckRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type UpdateDeckRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	DeckId            uint32                 `protobuf:"varint,1,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`
+	UserId            uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name              string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	SourceLanguage    string                 `protobuf:"bytes,5,opt,name=source_language,json=sourceLanguage,proto3" json:"source_language,omitempty"`
+	TargetLanguage    string                 `protobuf:"bytes,6,opt,name=target_language,json=targetLanguage,proto3" json:"target_language,omitempty"`
+	NewCardsPerDay    *int32                 `protobuf:"varint,7,opt,name=new_cards_per_day,json=newCardsPerDay,proto3,oneof" json:"new_cards_per_day,omitempty"`          // Set to 0 to use the server setting
+	ReviewCardsPerDay *int32                 `protobuf:"varint,8,opt,name=review_cards_per_day,json=reviewCardsPerDay,proto3,oneof" json:"review_cards_per_day,omitempty"` // Set to 0 to use the server setting
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateDeckRequest) Reset() {
+	*x = UpdateDeckRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDeckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDeckRequest) ProtoMessage() {}
+
+func (x *UpdateDeckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDeckRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDeckRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateDeckRequest) GetDeckId() uint32 {
+	if x != nil {
+		return x.DeckId
+	}
+	return 0
+}
+
+func (x *UpdateDeckRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateDeckRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateDeckRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateDeckRequest) GetSourceLanguage() string {
+	if x != nil {
+		return x.SourceLanguage
+	}
+	return ""
+}
+
+func (x *UpdateDeckRequest) GetTargetLanguage() string {
+	if x != nil {
+		return x.TargetLanguage
+	}
+	return ""
+}
+
+func (x *UpdateDeckRequest) GetNewCardsPerDay() int32 {
+	if x != nil && x.NewCardsPerDay != nil {
+		return *x.NewCardsPerDay
+	}
+	return 0
+}
+
+func (x *UpdateDeckRequest) GetReviewCardsPerDay() int32 {
+	if x != nil && x.ReviewCardsPerDay != nil {
+		return *x.ReviewCardsPerDay
+	}
+	return 0
+}
+
+type DeckVocabulariesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeckId        uint32                 `protobuf:"varint,1,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	VocabularyIds []uint32               `protobuf:"varint,3,rep,packed,name=vocabulary_ids,json=vocabularyIds,proto3" json:"vocabulary_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeckVocabulariesRequest) Reset() {
+	*x = DeckVocabulariesRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeckVocabulariesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeckVocabulariesRequest) ProtoMessage() {}
+
+func (x *DeckVocabulariesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeckVocabulariesRequest.ProtoReflect.Descriptor instead.
+func (*DeckVocabulariesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeckVocabulariesRequest) GetDeckId() uint32 {
+	if x != nil {
+		return x.DeckId
+	}
+	return 0
+}
+
+func (x *DeckVocabulariesRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeckVocabulariesRequest) GetVocabularyIds() []uint32 {
+	if x != nil {
+		return x.VocabularyIds
+	}
+	return nil
+}
+
+type TransferVocabulariesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SourceDeckId  uint32                 `protobuf:"varint,2,opt,name=source_deck_id,json=sourceDeckId,proto3" json:"source_deck_id,omitempty"`
+	TargetDeckId  uint32                 `protobuf:"varint,3,opt,name=target_deck_id,json=targetDeckId,proto3" json:"target_deck_id,omitempty"`
+	VocabularyIds []uint32               `protobuf:"varint,4,rep,packed,name=vocabulary_ids,json=vocabularyIds,proto3" json:"vocabulary_ids,omitempty"` // Optional: defaults to every entry in the source deck
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferVocabulariesRequest) Reset() {
+	*x = TransferVocabulariesRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferVocabulariesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferVocabulariesRequest) ProtoMessage() {}
+
+func (x *TransferVocabulariesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferVocabulariesRequest.ProtoReflect.Descriptor instead.
+func (*TransferVocabulariesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TransferVocabulariesRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *TransferVocabulariesRequest) GetSourceDeckId() uint32 {
+	if x != nil {
+		return x.SourceDeckId
+	}
+	return 0
+}
+
+func (x *TransferVocabulariesRequest) GetTargetDeckId() uint32 {
+	if x != nil {
+		return x.TargetDeckId
+	}
+	return 0
+}
+
+func (x *TransferVocabulariesRequest) GetVocabularyIds() []uint32 {
+	if x != nil {
+		return x.VocabularyIds
+	}
+	return nil
+}
+
+type FindDuplicatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindDuplicatesRequest) Reset() {
+	*x = FindDuplicatesRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindDuplicatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDuplicatesRequest) ProtoMessage() {}
+
+func (x *FindDuplicatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDuplicatesRequest.ProtoReflect.Descriptor instead.
+func (*FindDuplicatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *FindDuplicatesRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type MergeVocabulariesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TargetId      uint32                 `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`           // Entry to keep
+	SourceIds     []uint32               `protobuf:"varint,3,rep,packed,name=source_ids,json=sourceIds,proto3" json:"source_ids,omitempty"` // Entries merged into the target and deleted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeVocabulariesRequest) Reset() {
+	*x = MergeVocabulariesRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeVocabulariesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeVocabulariesRequest) ProtoMessage() {}
+
+func (x *MergeVocabulariesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeVocabulariesRequest.ProtoReflect.Descriptor instead.
+func (*MergeVocabulariesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MergeVocabulariesRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MergeVocabulariesRequest) GetTargetId() uint32 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *MergeVocabulariesRequest) GetSourceIds() []uint32 {
+	if x != nil {
+		return x.SourceIds
+	}
+	return nil
+}
+
+// The file is sent in chunks; user_id, format and on_duplicate are read
+// from the first message
+type ImportVocabulariesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`                              // "csv" (default) or "tsv"
+	OnDuplicate   string                 `protobuf:"bytes,3,opt,name=on_duplicate,json=onDuplicate,proto3" json:"on_duplicate,omitempty"` // "reject" (default) skips existing words, "merge" merges into them
+	Chunk         []byte                 `protobuf:"bytes,4,opt,name=chunk,proto3" json:"chunk,omitempty"`                                // Next part of the file
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportVocabulariesRequest) Reset() {
+	*x = ImportVocabulariesRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportVocabulariesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportVocabulariesRequest) ProtoMessage() {}
+
+func (x *ImportVocabulariesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportVocabulariesRequest.ProtoReflect.Descriptor instead.
+func (*ImportVocabulariesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ImportVocabulariesRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ImportVocabulariesRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportVocabulariesRequest) GetOnDuplicate() string {
+	if x != nil {
+		return x.OnDuplicate
+	}
+	return ""
+}
+
+func (x *ImportVocabulariesRequest) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type ExportVocabulariesRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	UserId        uint32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Format        string                  `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"` // "csv" (default), "tsv", "anki" or "json"
+	Filter        *GetVocabulariesRequest `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"` // Optional: same filters as GetVocabularies; user_id, limit and offset are ignored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportVocabulariesRequest) Reset() {
+	*x = ExportVocabulariesRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportVocabulariesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportVocabulariesRequest) ProtoMessage() {}
+
+func (x *ExportVocabulariesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportVocabulariesRequest.ProtoReflect.Descriptor instead.
+func (*ExportVocabulariesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ExportVocabulariesRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ExportVocabulariesRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportVocabulariesRequest) GetFilter() *GetVocabulariesRequest {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type BackupAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Profile       *AccountProfile        `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"` // Optional: the user's profile from the auth service, stored in the archive
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackupAccountRequest) Reset() {
+	*x = BackupAccountRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackupAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupAccountRequest) ProtoMessage() {}
+
+func (x *BackupAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupAccountRequest.ProtoReflect.Descriptor instead.
+func (*BackupAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BackupAccountRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BackupAccountRequest) GetProfile() *AccountProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type RestoreAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Archive       []byte                 `protobuf:"bytes,2,opt,name=archive,proto3" json:"archive,omitempty"` // JSON archive from BackupAccount
+	Mode          string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`       // "merge" (default) keeps existing data, "replace" deletes it first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreAccountRequest) Reset() {
+	*x = RestoreAccountRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreAccountRequest) ProtoMessage() {}
+
+func (x *RestoreAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreAccountRequest.ProtoReflect.Descriptor instead.
+func (*RestoreAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RestoreAccountRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RestoreAccountRequest) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+func (x *RestoreAccountRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+type SuggestWordsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Prefix        string                 `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"` // Start of the word, ignoring case
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`  // Default 10, at most 20
+	Sort          string                 `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`     // "recent" (default) or "frequent" (most consecutive successful reviews first)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestWordsRequest) Reset() {
+	*x = SuggestWordsRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestWordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestWordsRequest) ProtoMessage() {}
+
+func (x *SuggestWordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestWordsRequest.ProtoReflect.Descriptor instead.
+func (*SuggestWordsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SuggestWordsRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SuggestWordsRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *SuggestWordsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SuggestWordsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type CreateSavedSearchRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	UserId        uint32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Filter        *GetVocabulariesRequest `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"` // Same filters as GetVocabularies; user_id, limit and offset are ignored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSavedSearchRequest) Reset() {
+	*x = CreateSavedSearchRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSavedSearchRequest) ProtoMessage() {}
+
+func (x *CreateSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*CreateSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateSavedSearchRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateSavedSearchRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSavedSearchRequest) GetFilter() *GetVocabulariesRequest {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type GetSavedSearchesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSavedSearchesRequest) Reset() {
+	*x = GetSavedSearchesRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSavedSearchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSavedSearchesRequest) ProtoMessage() {}
+
+func (x *GetSavedSearchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSavedSearchesRequest.ProtoReflect.Descriptor instead.
+func (*GetSavedSearchesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetSavedSearchesRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type UpdateSavedSearchRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	UserId        uint32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SavedSearchId uint32                  `protobuf:"varint,2,opt,name=saved_search_id,json=savedSearchId,proto3" json:"saved_search_id,omitempty"`
+	Name          string                  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`     // Optional: empty keeps the name
+	Filter        *GetVocabulariesRequest `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"` // Optional: replaces every filter when set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSavedSearchRequest) Reset() {
+	*x = UpdateSavedSearchRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSavedSearchRequest) ProtoMessage() {}
+
+func (x *UpdateSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateSavedSearchRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateSavedSearchRequest) GetSavedSearchId() uint32 {
+	if x != nil {
+		return x.SavedSearchId
+	}
+	return 0
+}
+
+func (x *UpdateSavedSearchRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateSavedSearchRequest) GetFilter() *GetVocabulariesRequest {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type SavedSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SavedSearchId uint32                 `protobuf:"varint,2,opt,name=saved_search_id,json=savedSearchId,proto3" json:"saved_search_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedSearchRequest) Reset() {
+	*x = SavedSearchRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearchRequest) ProtoMessage() {}
+
+func (x *SavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*SavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SavedSearchRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SavedSearchRequest) GetSavedSearchId() uint32 {
+	if x != nil {
+		return x.SavedSearchId
+	}
+	return 0
+}
+
+type GetSavedSearchResultsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SavedSearchId uint32                 `protobuf:"varint,2,opt,name=saved_search_id,json=savedSearchId,proto3" json:"saved_search_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                // Optional: limit results
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`              // Optional: pagination offset
+	SortBy        string                 `protobuf:"bytes,5,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"` // Optional: as in GetVocabulariesRequest
+	SortOrder     string                 `protobuf:"bytes,6,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	PageToken     string                 `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSavedSearchResultsRequest) Reset() {
+	*x = GetSavedSearchResultsRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSavedSearchResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSavedSearchResultsRequest) ProtoMessage() {}
+
+func (x *GetSavedSearchResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSavedSearchResultsRequest.ProtoReflect.Descriptor instead.
+func (*GetSavedSearchResultsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetSavedSearchResultsRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetSavedSearchResultsRequest) GetSavedSearchId() uint32 {
+	if x != nil {
+		return x.SavedSearchId
+	}
+	return 0
+}
+
+func (x *GetSavedSearchResultsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetSavedSearchResultsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetSavedSearchResultsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *GetSavedSearchResultsRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+func (x *GetSavedSearchResultsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// The user_id of each item is ignored; items belong to the batch's user
+type BatchCreateVocabulariesRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	UserId        uint32                     `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Items         []*CreateVocabularyRequest `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"` // At most 500
+	Mode          string                     `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`   // "all_or_nothing" (default) or "best_effort"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateVocabulariesRequest) Reset() {
+	*x = BatchCreateVocabulariesRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateVocabulariesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateVocabulariesRequest) ProtoMessage() {}
+
+func (x *BatchCreateVocabulariesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateVocabulariesRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateVocabulariesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BatchCreateVocabulariesRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BatchCreateVocabulariesRequest) GetItems() []*CreateVocabularyRequest {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BatchCreateVocabulariesRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+type BatchUpdateVocabulariesRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	UserId        uint32                     `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Items         []*UpdateVocabularyRequest `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"` // At most 500, each entry once
+	Mode          string                     `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`   // "all_or_nothing" (default) or "best_effort"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateVocabulariesRequest) Reset() {
+	*x = BatchUpdateVocabulariesRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateVocabulariesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateVocabulariesRequest) ProtoMessage() {}
+
+func (x *BatchUpdateVocabulariesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateVocabulariesRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateVocabulariesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *BatchUpdateVocabulariesRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BatchUpdateVocabulariesRequest) GetItems() []*UpdateVocabularyRequest {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BatchUpdateVocabulariesRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+type BatchDeleteVocabulariesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	VocabularyIds []uint32               `protobuf:"varint,2,rep,packed,name=vocabulary_ids,json=vocabularyIds,proto3" json:"vocabulary_ids,omitempty"` // At most 500
+	Mode          string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`                                                // "all_or_nothing" (default) or "best_effort"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteVocabulariesRequest) Reset() {
+	*x = BatchDeleteVocabulariesRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteVocabulariesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteVocabulariesRequest) ProtoMessage() {}
+
+func (x *BatchDeleteVocabulariesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteVocabulariesRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteVocabulariesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BatchDeleteVocabulariesRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BatchDeleteVocabulariesRequest) GetVocabularyIds() []uint32 {
+	if x != nil {
+		return x.VocabularyIds
+	}
+	return nil
+}
+
+func (x *BatchDeleteVocabulariesRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+type UpdateVocabulariesByFilterRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	UserId        uint32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Filter        *GetVocabulariesRequest `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"` // Filters as in GetVocabularies; paging and sorting are ignored
+	Changes       *VocabularyChanges      `protobuf:"bytes,3,opt,name=changes,proto3" json:"changes,omitempty"`
+	DryRun        bool                    `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`             // Only count the matching entries and return a sample
+	SampleSize    int32                   `protobuf:"varint,5,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"` // Optional: entries in the sample, default 10, at most 50
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVocabulariesByFilterRequest) Reset() {
+	*x = UpdateVocabulariesByFilterRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVocabulariesByFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVocabulariesByFilterRequest) ProtoMessage() {}
+
+func (x *UpdateVocabulariesByFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVocabulariesByFilterRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVocabulariesByFilterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UpdateVocabulariesByFilterRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateVocabulariesByFilterRequest) GetFilter() *GetVocabulariesRequest {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *UpdateVocabulariesByFilterRequest) GetChanges() *VocabularyChanges {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *UpdateVocabulariesByFilterRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *UpdateVocabulariesByFilterRequest) GetSampleSize() int32 {
+	if x != nil {
+		return x.SampleSize
+	}
+	return 0
+}
+
+type ListTrashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`   // Optional: limit results
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"` // Optional: pagination offset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashRequest) Reset() {
+	*x = ListTrashRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashRequest) ProtoMessage() {}
+
+func (x *ListTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListTrashRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListTrashRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListTrashRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type RestoreVocabularyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	VocabularyId  uint32                 `protobuf:"varint,2,opt,name=vocabulary_id,json=vocabularyId,proto3" json:"vocabulary_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreVocabularyRequest) Reset() {
+	*x = RestoreVocabularyRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreVocabularyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreVocabularyRequest) ProtoMessage() {}
+
+func (x *RestoreVocabularyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreVocabularyRequest.ProtoReflect.Descriptor instead.
+func (*RestoreVocabularyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RestoreVocabularyRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RestoreVocabularyRequest) GetVocabularyId() uint32 {
+	if x != nil {
+		return x.VocabularyId
+	}
+	return 0
+}
+
+type PurgeTrashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	VocabularyIds []uint32               `protobuf:"varint,2,rep,packed,name=vocabulary_ids,json=vocabularyIds,proto3" json:"vocabulary_ids,omitempty"` // Optional: entries to purge; empty purges the whole trash
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeTrashRequest) Reset() {
+	*x = PurgeTrashRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTrashRequest) ProtoMessage() {}
+
+func (x *PurgeTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTrashRequest.ProtoReflect.Descriptor instead.
+func (*PurgeTrashRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PurgeTrashRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PurgeTrashRequest) GetVocabularyIds() []uint32 {
+	if x != nil {
+		return x.VocabularyIds
+	}
+	return nil
+}
+
+type GetVocabularyRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	VocabularyId  uint32                 `protobuf:"varint,2,opt,name=vocabulary_id,json=vocabularyId,proto3" json:"vocabulary_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`   // Optional: limit results
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"` // Optional: pagination offset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVocabularyRevisionsRequest) Reset() {
+	*x = GetVocabularyRevisionsRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVocabularyRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVocabularyRevisionsRequest) ProtoMessage() {}
+
+func (x *GetVocabularyRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVocabularyRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*GetVocabularyRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetVocabularyRevisionsRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetVocabularyRevisionsRequest) GetVocabularyId() uint32 {
+	if x != nil {
+		return x.VocabularyId
+	}
+	return 0
+}
+
+func (x *GetVocabularyRevisionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetVocabularyRevisionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type RevertVocabularyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	VocabularyId  uint32                 `protobuf:"varint,2,opt,name=vocabulary_id,json=vocabularyId,proto3" json:"vocabulary_id,omitempty"`
+	RevisionId    uint32                 `protobuf:"varint,3,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"` // Undo this revision and every later one
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertVocabularyRequest) Reset() {
+	*x = RevertVocabularyRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertVocabularyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertVocabularyRequest) ProtoMessage() {}
+
+func (x *RevertVocabularyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertVocabularyRequest.ProtoReflect.Descriptor instead.
+func (*RevertVocabularyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RevertVocabularyRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RevertVocabularyRequest) GetVocabularyId() uint32 {
+	if x != nil {
+		return x.VocabularyId
+	}
+	return 0
+}
+
+func (x *RevertVocabularyRequest) GetRevisionId() uint32 {
+	if x != nil {
+		return x.RevisionId
+	}
+	return 0
+}
+
+type GetStatusStagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatusStagesRequest) Reset() {
+	*x = GetStatusStagesRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatusStagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusStagesRequest) ProtoMessage() {}
+
+func (x *GetStatusStagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusStagesRequest.ProtoReflect.Descriptor instead.
+func (*GetStatusStagesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetStatusStagesRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type SetStatusStagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Stages        []*StatusStage         `protobuf:"bytes,2,rep,name=stages,proto3" json:"stages,omitempty"` // In learning order; none restores the built-in stages
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetStatusStagesRequest) Reset() {
+	*x = SetStatusStagesRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetStatusStagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStatusStagesRequest) ProtoMessage() {}
+
+func (x *SetStatusStagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStatusStagesRequest.ProtoReflect.Descriptor instead.
+func (*SetStatusStagesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SetStatusStagesRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetStatusStagesRequest) GetStages() []*StatusStage {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+type GetStatusTransitionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	VocabularyId  uint32                 `protobuf:"varint,2,opt,name=vocabulary_id,json=vocabularyId,proto3" json:"vocabulary_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`   // Optional: limit results
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"` // Optional: pagination offset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatusTransitionsRequest) Reset() {
+	*x = GetStatusTransitionsRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatusTransitionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusTransitionsRequest) ProtoMessage() {}
+
+func (x *GetStatusTransitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusTransitionsRequest.ProtoReflect.Descriptor instead.
+func (*GetStatusTransitionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetStatusTransitionsRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetStatusTransitionsRequest) GetVocabularyId() uint32 {
+	if x != nil {
+		return x.VocabularyId
+	}
+	return 0
+}
+
+func (x *GetStatusTransitionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetStatusTransitionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetProgressTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DateFrom      string                 `protobuf:"bytes,2,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"` // Optional: first day (YYYY-MM-DD); defaults to 30 days or 12 weeks up to date_to
+	DateTo        string                 `protobuf:"bytes,3,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`       // Optional: last day (YYYY-MM-DD); defaults to today
+	Interval      string                 `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"`                 // "day" (default) or "week"; weeks start on Monday
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProgressTimelineRequest) Reset() {
+	*x = GetProgressTimelineRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProgressTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProgressTimelineRequest) ProtoMessage() {}
+
+func (x *GetProgressTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProgressTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetProgressTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetProgressTimelineRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetProgressTimelineRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *GetProgressTimelineRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *GetProgressTimelineRequest) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+type GetVocabularyStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DateFrom      string                 `protobuf:"bytes,2,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"` // Optional: start date for stats
+	DateTo        string                 `protobuf:"bytes,3,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`       // Optional: end date for stats
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVocabularyStatsRequest) Reset() {
+	*x = GetVocabularyStatsRequest{}
+	mi := &file_proto_vocabulary_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVocabularyStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVocabularyStatsRequest) ProtoMessage() {}
+
+func (x *GetVocabularyStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVocabularyStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetVocabularyStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetVocabularyStatsRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetVocabularyStatsRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *GetVocabularyStatsRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+// Response messages
+type GetVocabulariesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Vocabularies  []*Vocabulary          `protobuf:"bytes,3,rep,name=vocabularies,proto3" json:"vocabularies,omitempty"`
+	Count         int32                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Total         int32                  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`                                       // Total count (for pagination)
+	ErrorPosition int32                  `protobuf:"varint,6,opt,name=error_position,json=errorPosition,proto3" json:"error_position,omitempty"`  // Position (from 1) of the problem when q cannot be parsed
+	NextPageToken string                 `protobuf:"bytes,7,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Token for the next page, empty on the last page or when sorting by relevance
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVocabulariesResponse) Reset() {
+	*x = GetVocabulariesResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVocabulariesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVocabulariesResponse) ProtoMessage() {}
+
+func (x *GetVocabulariesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVocabulariesResponse.ProtoReflect.Descriptor instead.
+func (*GetVocabulariesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetVocabulariesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetVocabulariesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetVocabulariesResponse) GetVocabularies() []*Vocabulary {
+	if x != nil {
+		return x.Vocabularies
+	}
+	return nil
+}
+
+func (x *GetVocabulariesResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetVocabulariesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetVocabulariesResponse) GetErrorPosition() int32 {
+	if x != nil {
+		return x.ErrorPosition
+	}
+	return 0
+}
+
+func (x *GetVocabulariesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetDueVocabulariesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Vocabularies  []*Vocabulary          `protobuf:"bytes,3,rep,name=vocabularies,proto3" json:"vocabularies,omitempty"`                   // Due reviews, most overdue first, then new cards
+	ReviewCount   int32                  `protobuf:"varint,4,opt,name=review_count,json=reviewCount,proto3" json:"review_count,omitempty"` // Review cards returned
+	NewCount      int32                  `protobuf:"varint,5,opt,name=new_count,json=newCount,proto3" json:"new_count,omitempty"`          // New cards returned
+	TotalDue      int32                  `protobuf:"varint,6,opt,name=total_due,json=totalDue,proto3" json:"total_due,omitempty"`          // Reviews due, ignoring the daily cap
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDueVocabulariesResponse) Reset() {
+	*x = GetDueVocabulariesResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDueVocabulariesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDueVocabulariesResponse) ProtoMessage() {}
+
+func (x *GetDueVocabulariesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDueVocabulariesResponse.ProtoReflect.Descriptor instead.
+func (*GetDueVocabulariesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetDueVocabulariesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetDueVocabulariesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetDueVocabulariesResponse) GetVocabularies() []*Vocabulary {
+	if x != nil {
+		return x.Vocabularies
+	}
+	return nil
+}
+
+func (x *GetDueVocabulariesResponse) GetReviewCount() int32 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
+func (x *GetDueVocabulariesResponse) GetNewCount() int32 {
+	if x != nil {
+		return x.NewCount
+	}
+	return 0
+}
+
+func (x *GetDueVocabulariesResponse) GetTotalDue() int32 {
+	if x != nil {
+		return x.TotalDue
+	}
+	return 0
+}
+
+type GetReviewHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Attempts      []*ReviewAttempt       `protobuf:"bytes,3,rep,name=attempts,proto3" json:"attempts,omitempty"` // Most recent first
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	AccuracyRate  float64                `protobuf:"fixed64,5,opt,name=accuracy_rate,json=accuracyRate,proto3" json:"accuracy_rate,omitempty"` // Share of attempts not graded "again" (0-1)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewHistoryResponse) Reset() {
+	*x = GetReviewHistoryResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewHistoryResponse) ProtoMessage() {}
+
+func (x *GetReviewHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetReviewHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetReviewHistoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetReviewHistoryResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetReviewHistoryResponse) GetAttempts() []*ReviewAttempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+func (x *GetReviewHistoryResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetReviewHistoryResponse) GetAccuracyRate() float64 {
+	if x != nil {
+		return x.AccuracyRate
+	}
+	return 0
+}
+
+type GenerateQuizResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Direction     string                 `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	Questions     []*QuizQuestion        `protobuf:"bytes,4,rep,name=questions,proto3" json:"questions,omitempty"`
+	QuizId        uint32                 `protobuf:"varint,5,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"` // Pass to SubmitQuiz; expires after 24 hours
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateQuizResponse) Reset() {
+	*x = GenerateQuizResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateQuizResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateQuizResponse) ProtoMessage() {}
+
+func (x *GenerateQuizResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateQuizResponse.ProtoReflect.Descriptor instead.
+func (*GenerateQuizResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GenerateQuizResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GenerateQuizResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GenerateQuizResponse) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *GenerateQuizResponse) GetQuestions() []*QuizQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *GenerateQuizResponse) GetQuizId() uint32 {
+	if x != nil {
+		return x.QuizId
+	}
+	return 0
+}
+
+type SubmitQuizResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Correct       int32                  `protobuf:"varint,3,opt,name=correct,proto3" json:"correct,omitempty"`
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Score         float64                `protobuf:"fixed64,5,opt,name=score,proto3" json:"score,omitempty"` // correct / total (0-1)
+	Results       []*QuizResult          `protobuf:"bytes,6,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitQuizResponse) Reset() {
+	*x = SubmitQuizResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitQuizResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitQuizResponse) ProtoMessage() {}
+
+func (x *SubmitQuizResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitQuizResponse.ProtoReflect.Descriptor instead.
+func (*SubmitQuizResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SubmitQuizResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SubmitQuizResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SubmitQuizResponse) GetCorrect() int32 {
+	if x != nil {
+		return x.Correct
+	}
+	return 0
+}
+
+func (x *SubmitQuizResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SubmitQuizResponse) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SubmitQuizResponse) GetResults() []*QuizResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GenerateClozeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Items         []*ClozeItem           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Unusable      []*ClozeIssue          `protobuf:"bytes,4,rep,name=unusable,proto3" json:"unusable,omitempty"` // Entries whose example cannot be turned into a cloze
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateClozeResponse) Reset() {
+	*x = GenerateClozeResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateClozeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateClozeResponse) ProtoMessage() {}
+
+func (x *GenerateClozeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateClozeResponse.ProtoReflect.Descriptor instead.
+func (*GenerateClozeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GenerateClozeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GenerateClozeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GenerateClozeResponse) GetItems() []*ClozeItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GenerateClozeResponse) GetUnusable() []*ClozeIssue {
+	if x != nil {
+		return x.Unusable
+	}
+	return nil
+}
+
+type GradeClozeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Correct       int32                  `protobuf:"varint,3,opt,name=correct,proto3" json:"correct,omitempty"`
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Results       []*ClozeResult         `protobuf:"bytes,5,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeClozeResponse) Reset() {
+	*x = GradeClozeResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeClozeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeClozeResponse) ProtoMessage() {}
+
+func (x *GradeClozeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeClozeResponse.ProtoReflect.Descriptor instead.
+func (*GradeClozeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GradeClozeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GradeClozeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GradeClozeResponse) GetCorrect() int32 {
+	if x != nil {
+		return x.Correct
+	}
+	return 0
+}
+
+func (x *GradeClozeResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GradeClozeResponse) GetResults() []*ClozeResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type DailyGoalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	DailyGoal     int32                  `protobuf:"varint,3,opt,name=daily_goal,json=dailyGoal,proto3" json:"daily_goal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyGoalResponse) Reset() {
+	*x = DailyGoalResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyGoalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyGoalResponse) ProtoMessage() {}
+
+func (x *DailyGoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyGoalResponse.ProtoReflect.Descriptor instead.
+func (*DailyGoalResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DailyGoalResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DailyGoalResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DailyGoalResponse) GetDailyGoal() int32 {
+	if x != nil {
+		return x.DailyGoal
+	}
+	return 0
+}
+
+type ListTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Tags          []*Tag                 `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListTagsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListTagsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListTagsResponse) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type TagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Tag           *Tag                   `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagResponse) Reset() {
+	*x = TagResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagResponse) ProtoMessage() {}
+
+func (x *TagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagResponse.ProtoReflect.Descriptor instead.
+func (*TagResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *TagResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TagResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TagResponse) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+type DeleteTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagResponse) Reset() {
+	*x = DeleteTagResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagResponse) ProtoMessage() {}
+
+func (x *DeleteTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTagResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DeleteTagResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteTagResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DeckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Deck          *Deck                  `protobuf:"bytes,3,opt,name=deck,proto3" json:"deck,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeckResponse) Reset() {
+	*x = DeckResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeckResponse) ProtoMessage() {}
+
+func (x *DeckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeckResponse.ProtoReflect.Descriptor instead.
+func (*DeckResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeckResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeckResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeckResponse) GetDeck() *Deck {
+	if x != nil {
+		return x.Deck
+	}
+	return nil
+}
+
+type GetDecksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Decks         []*Deck                `protobuf:"bytes,3,rep,name=decks,proto3" json:"decks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDecksResponse) Reset() {
+	*x = GetDecksResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDecksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDecksResponse) ProtoMessage() {}
+
+func (x *GetDecksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDecksResponse.ProtoReflect.Descriptor instead.
+func (*GetDecksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetDecksResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetDecksResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetDecksResponse) GetDecks() []*Deck {
+	if x != nil {
+		return x.Decks
+	}
+	return nil
+}
+
+type DeleteDeckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDeckResponse) Reset() {
+	*x = DeleteDeckResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDeckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDeckResponse) ProtoMessage() {}
+
+func (x *DeleteDeckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDeckResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDeckResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DeleteDeckResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteDeckResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DeckVocabulariesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Affected      int32                  `protobuf:"varint,3,opt,name=affected,proto3" json:"affected,omitempty"` // Entries added, removed, moved or copied
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeckVocabulariesResponse) Reset() {
+	*x = DeckVocabulariesResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeckVocabulariesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeckVocabulariesResponse) ProtoMessage() {}
+
+func (x *DeckVocabulariesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeckVocabulariesResponse.ProtoReflect.Descriptor instead.
+func (*DeckVocabulariesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DeckVocabulariesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeckVocabulariesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeckVocabulariesResponse) GetAffected() int32 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+type DeckStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Deck          *Deck                  `protobuf:"bytes,3,opt,name=deck,proto3" json:"deck,omitempty"`
+	TotalWords    int32                  `protobuf:"varint,4,opt,name=total_words,json=totalWords,proto3" json:"total_words,omitempty"`
+	StatusCounts  map[string]int32       `protobuf:"bytes,5,rep,name=status_counts,json=statusCounts,proto3" json:"status_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Count by status
+	DueCount      int32                  `protobuf:"varint,6,opt,name=due_count,json=dueCount,proto3" json:"due_count,omitempty"`                                                                                       // Reviews due now
+	NewCount      int32                  `protobuf:"varint,7,opt,name=new_count,json=newCount,proto3" json:"new_count,omitempty"`                                                                                       // Entries never reviewed
+	TotalReviews  int32                  `protobuf:"varint,8,opt,name=total_reviews,json=totalReviews,proto3" json:"total_reviews,omitempty"`
+	AccuracyRate  float64                `protobuf:"fixed64,9,opt,name=accuracy_rate,json=accuracyRate,proto3" json:"accuracy_rate,omitempty"` // Share of reviews not graded "again" (0-1)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeckStatsResponse) Reset() {
+	*x = DeckStatsResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeckStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeckStatsResponse) ProtoMessage() {}
+
+func (x *DeckStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeckStatsResponse.ProtoReflect.Descriptor instead.
+func (*DeckStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DeckStatsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeckStatsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeckStatsResponse) GetDeck() *Deck {
+	if x != nil {
+		return x.Deck
+	}
+	return nil
+}
+
+func (x *DeckStatsResponse) GetTotalWords() int32 {
+	if x != nil {
+		return x.TotalWords
+	}
+	return 0
+}
+
+func (x *DeckStatsResponse) GetStatusCounts() map[string]int32 {
+	if x != nil {
+		return x.StatusCounts
+	}
+	return nil
+}
+
+func (x *DeckStatsResponse) GetDueCount() int32 {
+	if x != nil {
+		return x.DueCount
+	}
+	return 0
+}
+
+func (x *DeckStatsResponse) GetNewCount() int32 {
+	if x != nil {
+		return x.NewCount
+	}
+	return 0
+}
+
+func (x *DeckStatsResponse) GetTotalReviews() int32 {
+	if x != nil {
+		return x.TotalReviews
+	}
+	return 0
+}
+
+func (x *DeckStatsResponse) GetAccuracyRate() float64 {
+	if x != nil {
+		return x.AccuracyRate
+	}
+	return 0
+}
+
+type FindDuplicatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Groups        []*DuplicateGroup      `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindDuplicatesResponse) Reset() {
+	*x = FindDuplicatesResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindDuplicatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDuplicatesResponse) ProtoMessage() {}
+
+func (x *FindDuplicatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDuplicatesResponse.ProtoReflect.Descriptor instead.
+func (*FindDuplicatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *FindDuplicatesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *FindDuplicatesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *FindDuplicatesResponse) GetGroups() []*DuplicateGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type ImportVocabulariesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Created       int32                  `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	Duplicates    int32                  `protobuf:"varint,4,opt,name=duplicates,proto3" json:"duplicates,omitempty"` // Rows skipped because the word already exists
+	Merged        int32                  `protobuf:"varint,5,opt,name=merged,proto3" json:"merged,omitempty"`
+	Failed        int32                  `protobuf:"varint,6,opt,name=failed,proto3" json:"failed,omitempty"`
+	Rows          []*ImportRowResult     `protobuf:"bytes,7,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportVocabulariesResponse) Reset() {
+	*x = ImportVocabulariesResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportVocabulariesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportVocabulariesResponse) ProtoMessage() {}
+
+func (x *ImportVocabulariesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportVocabulariesResponse.ProtoReflect.Descriptor instead.
+func (*ImportVocabulariesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ImportVocabulariesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ImportVocabulariesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ImportVocabulariesResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportVocabulariesResponse) GetDuplicates() int32 {
+	if x != nil {
+		return x.Duplicates
+	}
+	return 0
+}
+
+func (x *ImportVocabulariesResponse) GetMerged() int32 {
+	if x != nil {
+		return x.Merged
+	}
+	return 0
+}
+
+func (x *ImportVocabulariesResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportVocabulariesResponse) GetRows() []*ImportRowResult {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+// The first message reports whether the export could start; every message
+// carries the next part of the file
+type ExportVocabulariesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Chunk         []byte                 `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportVocabulariesResponse) Reset() {
+	*x = ExportVocabulariesResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportVocabulariesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportVocabulariesResponse) ProtoMessage() {}
+
+func (x *ExportVocabulariesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportVocabulariesResponse.ProtoReflect.Descriptor instead.
+func (*ExportVocabulariesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ExportVocabulariesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ExportVocabulariesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ExportVocabulariesResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type BackupAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Archive       []byte                 `protobuf:"bytes,3,opt,name=archive,proto3" json:"archive,omitempty"` // Versioned JSON archive
+	TotalWords    int32                  `protobuf:"varint,4,opt,name=total_words,json=totalWords,proto3" json:"total_words,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackupAccountResponse) Reset() {
+	*x = BackupAccountResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackupAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupAccountResponse) ProtoMessage() {}
+
+func (x *BackupAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupAccountResponse.ProtoReflect.Descriptor instead.
+func (*BackupAccountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *BackupAccountResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BackupAccountResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BackupAccountResponse) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+func (x *BackupAccountResponse) GetTotalWords() int32 {
+	if x != nil {
+		return x.TotalWords
+	}
+	return 0
+}
+
+type RestoreAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Created       int32                  `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"` // Entries added from the archive
+	Skipped       int32                  `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"` // Entries whose word already exists
+	Deleted       int32                  `protobuf:"varint,5,opt,name=deleted,proto3" json:"deleted,omitempty"` // Entries removed before a replace
+	Profile       *AccountProfile        `protobuf:"bytes,6,opt,name=profile,proto3" json:"profile,omitempty"`  // Profile stored in the archive
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreAccountResponse) Reset() {
+	*x = RestoreAccountResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreAccountResponse) ProtoMessage() {}
+
+func (x *RestoreAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreAccountResponse.ProtoReflect.Descriptor instead.
+func (*RestoreAccountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RestoreAccountResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RestoreAccountResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RestoreAccountResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *RestoreAccountResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *RestoreAccountResponse) GetDeleted() int32 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+func (x *RestoreAccountResponse) GetProfile() *AccountProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type SuggestWordsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Suggestions   []*WordSuggestion      `protobuf:"bytes,3,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestWordsResponse) Reset() {
+	*x = SuggestWordsResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestWordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestWordsResponse) ProtoMessage() {}
+
+func (x *SuggestWordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestWordsResponse.ProtoReflect.Descriptor instead.
+func (*SuggestWordsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SuggestWordsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SuggestWordsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SuggestWordsResponse) GetSuggestions() []*WordSuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type SavedSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	SavedSearch   *SavedSearch           `protobuf:"bytes,3,opt,name=saved_search,json=savedSearch,proto3" json:"saved_search,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedSearchResponse) Reset() {
+	*x = SavedSearchResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearchResponse) ProtoMessage() {}
+
+func (x *SavedSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearchResponse.ProtoReflect.Descriptor instead.
+func (*SavedSearchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SavedSearchResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SavedSearchResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SavedSearchResponse) GetSavedSearch() *SavedSearch {
+	if x != nil {
+		return x.SavedSearch
+	}
+	return nil
+}
+
+type GetSavedSearchesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	SavedSearches []*SavedSearch         `protobuf:"bytes,3,rep,name=saved_searches,json=savedSearches,proto3" json:"saved_searches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSavedSearchesResponse) Reset() {
+	*x = GetSavedSearchesResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSavedSearchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSavedSearchesResponse) ProtoMessage() {}
+
+func (x *GetSavedSearchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSavedSearchesResponse.ProtoReflect.Descriptor instead.
+func (*GetSavedSearchesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetSavedSearchesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetSavedSearchesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetSavedSearchesResponse) GetSavedSearches() []*SavedSearch {
+	if x != nil {
+		return x.SavedSearches
+	}
+	return nil
+}
+
+type DeleteSavedSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavedSearchResponse) Reset() {
+	*x = DeleteSavedSearchResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavedSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedSearchResponse) ProtoMessage() {}
+
+func (x *DeleteSavedSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedSearchResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSavedSearchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DeleteSavedSearchResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteSavedSearchResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type UpdateVocabulariesByFilterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Matched       int32                  `protobuf:"varint,3,opt,name=matched,proto3" json:"matched,omitempty"` // Entries matching the filter
+	Updated       int32                  `protobuf:"varint,4,opt,name=updated,proto3" json:"updated,omitempty"` // Entries changed; 0 for a dry run
+	DryRun        bool                   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Sample        []*Vocabulary          `protobuf:"bytes,6,rep,name=sample,proto3" json:"sample,omitempty"`                                     // Some matching entries: as they are for a dry run, as changed otherwise
+	ErrorPosition int32                  `protobuf:"varint,7,opt,name=error_position,json=errorPosition,proto3" json:"error_position,omitempty"` // Position of the error in filter.q, from 1
+	Skipped       int32                  `protobuf:"varint,8,opt,name=skipped,proto3" json:"skipped,omitempty"`                                  // Matching entries whose stage cannot move to changes.status; they are left unchanged
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVocabulariesByFilterResponse) Reset() {
+	*x = UpdateVocabulariesByFilterResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVocabulariesByFilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVocabulariesByFilterResponse) ProtoMessage() {}
+
+func (x *UpdateVocabulariesByFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVocabulariesByFilterResponse.ProtoReflect.Descriptor instead.
+func (*UpdateVocabulariesByFilterResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UpdateVocabulariesByFilterResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateVocabulariesByFilterResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateVocabulariesByFilterResponse) GetMatched() int32 {
+	if x != nil {
+		return x.Matched
+	}
+	return 0
+}
+
+func (x *UpdateVocabulariesByFilterResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *UpdateVocabulariesByFilterResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *UpdateVocabulariesByFilterResponse) GetSample() []*Vocabulary {
+	if x != nil {
+		return x.Sample
+	}
+	return nil
+}
+
+func (x *UpdateVocabulariesByFilterResponse) GetErrorPosition() int32 {
+	if x != nil {
+		return x.ErrorPosition
+	}
+	return 0
+}
+
+func (x *UpdateVocabulariesByFilterResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+type ListTrashResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Vocabularies  []*Vocabulary          `protobuf:"bytes,3,rep,name=vocabularies,proto3" json:"vocabularies,omitempty"` // Most recently deleted first
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	RetentionDays int32                  `protobuf:"varint,5,opt,name=retention_days,json=retentionDays,proto3" json:"retention_days,omitempty"` // Days entries stay in the trash; 0 if they are kept until purged
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashResponse) Reset() {
+	*x = ListTrashResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashResponse) ProtoMessage() {}
+
+func (x *ListTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListTrashResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListTrashResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListTrashResponse) GetVocabularies() []*Vocabulary {
+	if x != nil {
+		return x.Vocabularies
+	}
+	return nil
+}
+
+func (x *ListTrashResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListTrashResponse) GetRetentionDays() int32 {
+	if x != nil {
+		return x.RetentionDays
+	}
+	return 0
+}
+
+type PurgeTrashResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Purged        int32                  `protobuf:"varint,3,opt,name=purged,proto3" json:"purged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeTrashResponse) Reset() {
+	*x = PurgeTrashResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTrashResponse) ProtoMessage() {}
+
+func (x *PurgeTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTrashResponse.ProtoReflect.Descriptor instead.
+func (*PurgeTrashResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *PurgeTrashResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PurgeTrashResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PurgeTrashResponse) GetPurged() int32 {
+	if x != nil {
+		return x.Purged
+	}
+	return 0
+}
+
+type GetVocabularyRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Revisions     []*VocabularyRevision  `protobuf:"bytes,3,rep,name=revisions,proto3" json:"revisions,omitempty"` // Most recent first
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVocabularyRevisionsResponse) Reset() {
+	*x = GetVocabularyRevisionsResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVocabularyRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVocabularyRevisionsResponse) ProtoMessage() {}
+
+func (x *GetVocabularyRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVocabularyRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*GetVocabularyRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetVocabularyRevisionsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetVocabularyRevisionsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetVocabularyRevisionsResponse) GetRevisions() []*VocabularyRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *GetVocabularyRevisionsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type StatusStagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Stages        []*StatusStage         `protobuf:"bytes,3,rep,name=stages,proto3" json:"stages,omitempty"`    // In learning order
+	Custom        bool                   `protobuf:"varint,4,opt,name=custom,proto3" json:"custom,omitempty"`   // The user has custom stages
+	Updated       int32                  `protobuf:"varint,5,opt,name=updated,proto3" json:"updated,omitempty"` // Entries moved to another stage or status by SetStatusStages
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusStagesResponse) Reset() {
+	*x = StatusStagesResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusStagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusStagesResponse) ProtoMessage() {}
+
+func (x *StatusStagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusStagesResponse.ProtoReflect.Descriptor instead.
+func (*StatusStagesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *StatusStagesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *StatusStagesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StatusStagesResponse) GetStages() []*StatusStage {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+func (x *StatusStagesResponse) GetCustom() bool {
+	if x != nil {
+		return x.Custom
+	}
+	return false
+}
+
+func (x *StatusStagesResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+type GetStatusTransitionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Transitions   []*StatusTransition    `protobuf:"bytes,3,rep,name=transitions,proto3" json:"transitions,omitempty"` // Most recent first
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatusTransitionsResponse) Reset() {
+	*x = GetStatusTransitionsResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatusTransitionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusTransitionsResponse) ProtoMessage() {}
+
+func (x *GetStatusTransitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusTransitionsResponse.ProtoReflect.Descriptor instead.
+func (*GetStatusTransitionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetStatusTransitionsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetStatusTransitionsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetStatusTransitionsResponse) GetTransitions() []*StatusTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+func (x *GetStatusTransitionsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetProgressTimelineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Interval      string                 `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	Points        []*ProgressPoint       `protobuf:"bytes,4,rep,name=points,proto3" json:"points,omitempty"` // One per day or week, oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProgressTimelineResponse) Reset() {
+	*x = GetProgressTimelineResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProgressTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProgressTimelineResponse) ProtoMessage() {}
+
+func (x *GetProgressTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProgressTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetProgressTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetProgressTimelineResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetProgressTimelineResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetProgressTimelineResponse) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *GetProgressTimelineResponse) GetPoints() []*ProgressPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+// Success is false when an all or nothing batch was rolled back
+type BatchVocabulariesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Results       []*BatchItemResult     `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"` // One per item, in order
+	Succeeded     int32                  `protobuf:"varint,4,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchVocabulariesResponse) Reset() {
+	*x = BatchVocabulariesResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchVocabulariesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchVocabulariesResponse) ProtoMessage() {}
+
+func (x *BatchVocabulariesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchVocabulariesResponse.ProtoReflect.Descriptor instead.
+func (*BatchVocabulariesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *BatchVocabulariesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BatchVocabulariesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BatchVocabulariesResponse) GetResults() []*BatchItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchVocabulariesResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BatchVocabulariesResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type VocabularyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Vocabulary    *Vocabulary            `protobuf:"bytes,3,opt,name=vocabulary,proto3" json:"vocabulary,omitempty"`
+	Duplicate     bool                   `protobuf:"varint,4,opt,name=duplicate,proto3" json:"duplicate,omitempty"` // The word already exists; vocabulary is the existing entry
+	Merged        bool                   `protobuf:"varint,5,opt,name=merged,proto3" json:"merged,omitempty"`       // The request was merged into an existing entry
+	Conflict      bool                   `protobuf:"varint,6,opt,name=conflict,proto3" json:"conflict,omitempty"`   // The entry is not at expected_version; vocabulary is the current entry
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyResponse) Reset() {
+	*x = VocabularyResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyResponse) ProtoMessage() {}
+
+func (x *VocabularyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyResponse.ProtoReflect.Descriptor instead.
+func (*VocabularyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *VocabularyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *VocabularyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *VocabularyResponse) GetVocabulary() *Vocabulary {
+	if x != nil {
+		return x.Vocabulary
+	}
+	return nil
+}
+
+func (x *VocabularyResponse) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+func (x *VocabularyResponse) GetMerged() bool {
+	if x != nil {
+		return x.Merged
+	}
+	return false
+}
+
+func (x *VocabularyResponse) GetConflict() bool {
+	if x != nil {
+		return x.Conflict
+	}
+	return false
+}
+
+type DeleteVocabularyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVocabularyResponse) Reset() {
+	*x = DeleteVocabularyResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVocabularyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVocabularyResponse) ProtoMessage() {}
+
+func (x *DeleteVocabularyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVocabularyResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVocabularyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *DeleteVocabularyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteVocabularyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type VocabularyStatsResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Success               bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message               string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	TotalWords            int32                  `protobuf:"varint,3,opt,name=total_words,json=totalWords,proto3" json:"total_words,omitempty"`
+	WordsThisWeek         int32                  `protobuf:"varint,4,opt,name=words_this_week,json=wordsThisWeek,proto3" json:"words_this_week,omitempty"`
+	WordsThisMonth        int32                  `protobuf:"varint,5,opt,name=words_this_month,json=wordsThisMonth,proto3" json:"words_this_month,omitempty"`
+	StatusCounts          map[string]int32       `protobuf:"bytes,6,rep,name=status_counts,json=statusCounts,proto3" json:"status_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Count by status
+	DailyCounts           []*DailyCount          `protobuf:"bytes,7,rep,name=daily_counts,json=dailyCounts,proto3" json:"daily_counts,omitempty"`                                                                               // Daily word counts
+	TotalReviews          int32                  `protobuf:"varint,8,opt,name=total_reviews,json=totalReviews,proto3" json:"total_reviews,omitempty"`                                                                           // Review attempts in the date range
+	AccuracyRate          float64                `protobuf:"fixed64,9,opt,name=accuracy_rate,json=accuracyRate,proto3" json:"accuracy_rate,omitempty"`                                                                          // Share of reviews not graded "again" (0-1)
+	AverageResponseTimeMs float64                `protobuf:"fixed64,10,opt,name=average_response_time_ms,json=averageResponseTimeMs,proto3" json:"average_response_time_ms,omitempty"`                                          // Average over reviews with a response time
+	HardestWords          []*HardWord            `protobuf:"bytes,11,rep,name=hardest_words,json=hardestWords,proto3" json:"hardest_words,omitempty"`                                                                           // Words with the lowest recall accuracy
+	CurrentStreak         int32                  `protobuf:"varint,12,opt,name=current_streak,json=currentStreak,proto3" json:"current_streak,omitempty"`                                                                       // Consecutive days with a word added or reviewed
+	LongestStreak         int32                  `protobuf:"varint,13,opt,name=longest_streak,json=longestStreak,proto3" json:"longest_streak,omitempty"`
+	DailyGoal             int32                  `protobuf:"varint,14,opt,name=daily_goal,json=dailyGoal,proto3" json:"daily_goal,omitempty"`                                                                                 // Words to add or review each day
+	TodayProgress         int32                  `protobuf:"varint,15,opt,name=today_progress,json=todayProgress,proto3" json:"today_progress,omitempty"`                                                                     // Words added or reviewed today
+	StageCounts           map[string]int32       `protobuf:"bytes,16,rep,name=stage_counts,json=stageCounts,proto3" json:"stage_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Count by stage
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *VocabularyStatsResponse) Reset() {
+	*x = VocabularyStatsResponse{}
+	mi := &file_proto_vocabulary_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyStatsResponse) ProtoMessage() {}
+
+func (x *VocabularyStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyStatsResponse.ProtoReflect.Descriptor instead.
+func (*VocabularyStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *VocabularyStatsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *VocabularyStatsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *VocabularyStatsResponse) GetTotalWords() int32 {
+	if x != nil {
+		return x.TotalWords
+	}
+	return 0
+}
+
+func (x *VocabularyStatsResponse) GetWordsThisWeek() int32 {
+	if x != nil {
+		return x.WordsThisWeek
+	}
+	return 0
+}
+
+func (x *VocabularyStatsResponse) GetWordsThisMonth() int32 {
+	if x != nil {
+		return x.WordsThisMonth
+	}
+	return 0
+}
+
+func (x *VocabularyStatsResponse) GetStatusCounts() map[string]int32 {
+	if x != nil {
+		return x.StatusCounts
+	}
+	return nil
+}
+
+func (x *VocabularyStatsResponse) GetDailyCounts() []*DailyCount {
+	if x != nil {
+		return x.DailyCounts
+	}
+	return nil
+}
+
+func (x *VocabularyStatsResponse) GetTotalReviews() int32 {
+	if x != nil {
+		return x.TotalReviews
+	}
+	return 0
+}
+
+func (x *VocabularyStatsResponse) GetAccuracyRate() float64 {
+	if x != nil {
+		return x.AccuracyRate
+	}
+	return 0
+}
+
+func (x *VocabularyStatsResponse) GetAverageResponseTimeMs() float64 {
+	if x != nil {
+		return x.AverageResponseTimeMs
+	}
+	return 0
+}
+
+func (x *VocabularyStatsResponse) GetHardestWords() []*HardWord {
+	if x != nil {
+		return x.HardestWords
+	}
+	return nil
+}
+
+func (x *VocabularyStatsResponse) GetCurrentStreak() int32 {
+	if x != nil {
+		return x.CurrentStreak
+	}
+	return 0
+}
+
+func (x *VocabularyStatsResponse) GetLongestStreak() int32 {
+	if x != nil {
+		return x.LongestStreak
+	}
+	return 0
+}
+
+func (x *VocabularyStatsResponse) GetDailyGoal() int32 {
+	if x != nil {
+		return x.DailyGoal
+	}
+	return 0
+}
+
+func (x *VocabularyStatsResponse) GetTodayProgress() int32 {
+	if x != nil {
+		return x.TodayProgress
+	}
+	return 0
+}
+
+func (x *VocabularyStatsResponse) GetStageCounts() map[string]int32 {
+	if x != nil {
+		return x.StageCounts
+	}
+	return nil
+}
+
+type Vocabulary struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId          uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Word            string                 `protobuf:"bytes,3,opt,name=word,proto3" json:"word,omitempty"`
+	Meaning         string                 `protobuf:"bytes,4,opt,name=meaning,proto3" json:"meaning,omitempty"`
+	Example         string                 `protobuf:"bytes,5,opt,name=example,proto3" json:"example,omitempty"`
+	Date            string                 `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`                                                                                        // YYYY-MM-DD format
+	CreatedAt       string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                                             // RFC3339 format
+	UpdatedAt       string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                                                             // RFC3339 format
+	EaseFactor      float64                `protobuf:"fixed64,10,opt,name=ease_factor,json=easeFactor,proto3" json:"ease_factor,omitempty"`                                                       // SM-2 ease factor
+	IntervalDays    int32                  `protobuf:"varint,11,opt,name=interval_days,json=intervalDays,proto3" json:"interval_days,omitempty"`                                                  // Current review interval in days
+	Repetitions     int32                  `protobuf:"varint,12,opt,name=repetitions,proto3" json:"repetitions,omitempty"`                                                                        // Consecutive successful reviews
+	NextReviewAt    string                 `protobuf:"bytes,13,opt,name=next_review_at,json=nextReviewAt,proto3" json:"next_review_at,omitempty"`                                                 // RFC3339 format, empty if never reviewed
+	LastReviewedAt  string                 `protobuf:"bytes,14,opt,name=last_reviewed_at,json=lastReviewedAt,proto3" json:"last_reviewed_at,omitempty"`                                           // RFC3339 format, empty if never reviewed
+	FirstReviewedAt string                 `protobuf:"bytes,15,opt,name=first_reviewed_at,json=firstReviewedAt,proto3" json:"first_reviewed_at,omitempty"`                                        // RFC3339 format, empty if never reviewed
+	Tags            []string               `protobuf:"bytes,16,rep,name=tags,proto3" json:"tags,omitempty"`                                                                                       // Tag names, sorted
+	Highlights      map[string]string      `protobuf:"bytes,17,rep,name=highlights,proto3" json:"highlights,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Search matches by field (word, meaning, example), marked with <mark></mark>
+	DeletedAt       string                 `protobuf:"bytes,18,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`                                                            // RFC3339 format, set for entries in the trash
+	Version         int32                  `protobuf:"varint,19,opt,name=version,proto3" json:"version,omitempty"`                                                                                // Increases with every change to the entry, starting at 1
+	Status          VocabularyStatus       `protobuf:"varint,20,opt,name=status,proto3,enum=vocabulary.VocabularyStatus" json:"status,omitempty"`
+	Stage           string                 `protobuf:"bytes,21,opt,name=stage,proto3" json:"stage,omitempty"` // The user's stage, e.g. "familiar"; the status name without custom stages
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Vocabulary) Reset() {
+	*x = Vocabulary{}
+	mi := &file_proto_vocabulary_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Vocabulary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vocabulary) ProtoMessage() {}
+
+func (x *Vocabulary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vocabulary.ProtoReflect.Descriptor instead.
+func (*Vocabulary) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *Vocabulary) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Vocabulary) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Vocabulary) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *Vocabulary) GetMeaning() string {
+	if x != nil {
+		return x.Meaning
+	}
+	return ""
+}
+
+func (x *Vocabulary) GetExample() string {
+	if x != nil {
+		return x.Example
+	}
+	return ""
+}
+
+func (x *Vocabulary) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Vocabulary) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Vocabulary) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Vocabulary) GetEaseFactor() float64 {
+	if x != nil {
+		return x.EaseFactor
+	}
+	return 0
+}
+
+func (x *Vocabulary) GetIntervalDays() int32 {
+	if x != nil {
+		return x.IntervalDays
+	}
+	return 0
+}
+
+func (x *Vocabulary) GetRepetitions() int32 {
+	if x != nil {
+		return x.Repetitions
+	}
+	return 0
+}
+
+func (x *Vocabulary) GetNextReviewAt() string {
+	if x != nil {
+		return x.NextReviewAt
+	}
+	return ""
+}
+
+func (x *Vocabulary) GetLastReviewedAt() string {
+	if x != nil {
+		return x.LastReviewedAt
+	}
+	return ""
+}
+
+func (x *Vocabulary) GetFirstReviewedAt() string {
+	if x != nil {
+		return x.FirstReviewedAt
+	}
+	return ""
+}
+
+func (x *Vocabulary) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Vocabulary) GetHighlights() map[string]string {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+func (x *Vocabulary) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *Vocabulary) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Vocabulary) GetStatus() VocabularyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return VocabularyStatus_VOCABULARY_STATUS_UNSPECIFIED
+}
+
+func (x *Vocabulary) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+// A learning stage. An entry in the stage counts as having its status.
+type StatusStage struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Lowercase letters, digits, "_" and "-"
+	Status VocabularyStatus       `protobuf:"varint,2,opt,name=status,proto3,enum=vocabulary.VocabularyStatus" json:"status,omitempty"`
+	// Stages an entry can move to from this one. Defaults to the next stage
+	// and every earlier one.
+	Next          []string `protobuf:"bytes,3,rep,name=next,proto3" json:"next,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusStage) Reset() {
+	*x = StatusStage{}
+	mi := &file_proto_vocabulary_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusStage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusStage) ProtoMessage() {}
+
+func (x *StatusStage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusStage.ProtoReflect.Descriptor instead.
+func (*StatusStage) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *StatusStage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StatusStage) GetStatus() VocabularyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return VocabularyStatus_VOCABULARY_STATUS_UNSPECIFIED
+}
+
+func (x *StatusStage) GetNext() []string {
+	if x != nil {
+		return x.Next
+	}
+	return nil
+}
+
+// A move of an entry from one stage to another
+type StatusTransition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	VocabularyId  uint32                 `protobuf:"varint,2,opt,name=vocabulary_id,json=vocabularyId,proto3" json:"vocabulary_id,omitempty"`
+	FromStage     string                 `protobuf:"bytes,3,opt,name=from_stage,json=fromStage,proto3" json:"from_stage,omitempty"` // Empty when the entry was created
+	ToStage       string                 `protobuf:"bytes,4,opt,name=to_stage,json=toStage,proto3" json:"to_stage,omitempty"`
+	FromStatus    VocabularyStatus       `protobuf:"varint,5,opt,name=from_status,json=fromStatus,proto3,enum=vocabulary.VocabularyStatus" json:"from_status,omitempty"` // Unspecified when the entry was created
+	ToStatus      VocabularyStatus       `protobuf:"varint,6,opt,name=to_status,json=toStatus,proto3,enum=vocabulary.VocabularyStatus" json:"to_status,omitempty"`
+	Source        string                 `protobuf:"bytes,7,opt,name=source,proto3" json:"source,omitempty"`                        // "create", "update", "bulk_update", "revert", "review", "quiz" or "stages"
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339 format
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusTransition) Reset() {
+	*x = StatusTransition{}
+	mi := &file_proto_vocabulary_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusTransition) ProtoMessage() {}
+
+func (x *StatusTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusTransition.ProtoReflect.Descriptor instead.
+func (*StatusTransition) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *StatusTransition) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StatusTransition) GetVocabularyId() uint32 {
+	if x != nil {
+		return x.VocabularyId
+	}
+	return 0
+}
+
+func (x *StatusTransition) GetFromStage() string {
+	if x != nil {
+		return x.FromStage
+	}
+	return ""
+}
+
+func (x *StatusTransition) GetToStage() string {
+	if x != nil {
+		return x.ToStage
+	}
+	return ""
+}
+
+func (x *StatusTransition) GetFromStatus() VocabularyStatus {
+	if x != nil {
+		return x.FromStatus
+	}
+	return VocabularyStatus_VOCABULARY_STATUS_UNSPECIFIED
+}
+
+func (x *StatusTransition) GetToStatus() VocabularyStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return VocabularyStatus_VOCABULARY_STATUS_UNSPECIFIED
+}
+
+func (x *StatusTransition) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *StatusTransition) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type Tag struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	VocabularyCount int32                  `protobuf:"varint,3,opt,name=vocabulary_count,json=vocabularyCount,proto3" json:"vocabulary_count,omitempty"` // Entries using this tag
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_proto_vocabulary_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *Tag) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tag) GetVocabularyCount() int32 {
+	if x != nil {
+		return x.VocabularyCount
+	}
+	return 0
+}
+
+type Deck struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId            uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name              string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	SourceLanguage    string                 `protobuf:"bytes,5,opt,name=source_language,json=sourceLanguage,proto3" json:"source_language,omitempty"`
+	TargetLanguage    string                 `protobuf:"bytes,6,opt,name=target_language,json=targetLanguage,proto3" json:"target_language,omitempty"`
+	NewCardsPerDay    int32                  `protobuf:"varint,7,opt,name=new_cards_per_day,json=newCardsPerDay,proto3" json:"new_cards_per_day,omitempty"`          // 0 uses the server setting
+	ReviewCardsPerDay int32                  `protobuf:"varint,8,opt,name=review_cards_per_day,json=reviewCardsPerDay,proto3" json:"review_cards_per_day,omitempty"` // 0 uses the server setting
+	VocabularyCount   int32                  `protobuf:"varint,9,opt,name=vocabulary_count,json=vocabularyCount,proto3" json:"vocabulary_count,omitempty"`
+	CreatedAt         string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339 format
+	UpdatedAt         string                 `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // RFC3339 format
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Deck) Reset() {
+	*x = Deck{}
+	mi := &file_proto_vocabulary_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Deck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deck) ProtoMessage() {}
+
+func (x *Deck) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deck.ProtoReflect.Descriptor instead.
+func (*Deck) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *Deck) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Deck) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Deck) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Deck) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Deck) GetSourceLanguage() string {
+	if x != nil {
+		return x.SourceLanguage
+	}
+	return ""
+}
+
+func (x *Deck) GetTargetLanguage() string {
+	if x != nil {
+		return x.TargetLanguage
+	}
+	return ""
+}
+
+func (x *Deck) GetNewCardsPerDay() int32 {
+	if x != nil {
+		return x.NewCardsPerDay
+	}
+	return 0
+}
+
+func (x *Deck) GetReviewCardsPerDay() int32 {
+	if x != nil {
+		return x.ReviewCardsPerDay
+	}
+	return 0
+}
+
+func (x *Deck) GetVocabularyCount() int32 {
+	if x != nil {
+		return x.VocabularyCount
+	}
+	return 0
+}
+
+func (x *Deck) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Deck) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type SavedSearch struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            uint32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        uint32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Filter        *GetVocabulariesRequest `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`                        // The saved filters; user_id, limit and offset are not set
+	CreatedAt     string                  `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339 format
+	UpdatedAt     string                  `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // RFC3339 format
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedSearch) Reset() {
+	*x = SavedSearch{}
+	mi := &file_proto_vocabulary_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearch) ProtoMessage() {}
+
+func (x *SavedSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearch.ProtoReflect.Descriptor instead.
+func (*SavedSearch) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SavedSearch) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SavedSearch) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SavedSearch) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetFilter() *GetVocabulariesRequest {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *SavedSearch) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type AccountProfile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountProfile) Reset() {
+	*x = AccountProfile{}
+	mi := &file_proto_vocabulary_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountProfile) ProtoMessage() {}
+
+func (x *AccountProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountProfile.ProtoReflect.Descriptor instead.
+func (*AccountProfile) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *AccountProfile) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AccountProfile) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AccountProfile) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type DuplicateGroup struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NormalizedWord string                 `protobuf:"bytes,1,opt,name=normalized_word,json=normalizedWord,proto3" json:"normalized_word,omitempty"`
+	Vocabularies   []*Vocabulary          `protobuf:"bytes,2,rep,name=vocabularies,proto3" json:"vocabularies,omitempty"` // Oldest first
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DuplicateGroup) Reset() {
+	*x = DuplicateGroup{}
+	mi := &file_proto_vocabulary_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateGroup) ProtoMessage() {}
+
+func (x *DuplicateGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateGroup.ProtoReflect.Descriptor instead.
+func (*DuplicateGroup) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *DuplicateGroup) GetNormalizedWord() string {
+	if x != nil {
+		return x.NormalizedWord
+	}
+	return ""
+}
+
+func (x *DuplicateGroup) GetVocabularies() []*Vocabulary {
+	if x != nil {
+		return x.Vocabularies
+	}
+	return nil
+}
+
+type WordSuggestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Word          string                 `protobuf:"bytes,2,opt,name=word,proto3" json:"word,omitempty"`
+	Meaning       string                 `protobuf:"bytes,3,opt,name=meaning,proto3" json:"meaning,omitempty"`
+	ReviewCount   int32                  `protobuf:"varint,5,opt,name=review_count,json=reviewCount,proto3" json:"review_count,omitempty"`
+	Status        VocabularyStatus       `protobuf:"varint,6,opt,name=status,proto3,enum=vocabulary.VocabularyStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WordSuggestion) Reset() {
+	*x = WordSuggestion{}
+	mi := &file_proto_vocabulary_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WordSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WordSuggestion) ProtoMessage() {}
+
+func (x *WordSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WordSuggestion.ProtoReflect.Descriptor instead.
+func (*WordSuggestion) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *WordSuggestion) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WordSuggestion) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *WordSuggestion) GetMeaning() string {
+	if x != nil {
+		return x.Meaning
+	}
+	return ""
+}
+
+func (x *WordSuggestion) GetReviewCount() int32 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
+func (x *WordSuggestion) GetStatus() VocabularyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return VocabularyStatus_VOCABULARY_STATUS_UNSPECIFIED
+}
+
+// Changes applied to every entry matched by UpdateVocabulariesByFilter
+type VocabularyChanges struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`                           // Optional: the stage to move to; entries that cannot move there are skipped
+	AddTags       []string               `protobuf:"bytes,2,rep,name=add_tags,json=addTags,proto3" json:"add_tags,omitempty"`          // Optional: tags added, created if they do not exist
+	RemoveTags    []string               `protobuf:"bytes,3,rep,name=remove_tags,json=removeTags,proto3" json:"remove_tags,omitempty"` // Optional: tags removed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyChanges) Reset() {
+	*x = VocabularyChanges{}
+	mi := &file_proto_vocabulary_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyChanges) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyChanges) ProtoMessage() {}
+
+func (x *VocabularyChanges) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyChanges.ProtoReflect.Descriptor instead.
+func (*VocabularyChanges) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *VocabularyChanges) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *VocabularyChanges) GetAddTags() []string {
+	if x != nil {
+		return x.AddTags
+	}
+	return nil
+}
+
+func (x *VocabularyChanges) GetRemoveTags() []string {
+	if x != nil {
+		return x.RemoveTags
+	}
+	return nil
+}
+
+type BatchItemResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // Position of the item in the batch, from 0
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`                                // Why the item failed or was not applied
+	VocabularyId  uint32                 `protobuf:"varint,4,opt,name=vocabulary_id,json=vocabularyId,proto3" json:"vocabulary_id,omitempty"` // The updated, deleted or existing entry
+	Vocabulary    *Vocabulary            `protobuf:"bytes,5,opt,name=vocabulary,proto3" json:"vocabulary,omitempty"`                          // The entry after the change; the existing entry for a duplicate
+	Duplicate     bool                   `protobuf:"varint,6,opt,name=duplicate,proto3" json:"duplicate,omitempty"`                           // The word already exists
+	Merged        bool                   `protobuf:"varint,7,opt,name=merged,proto3" json:"merged,omitempty"`                                 // Merged into the existing entry
+	Conflict      bool                   `protobuf:"varint,8,opt,name=conflict,proto3" json:"conflict,omitempty"`                             // Not at expected_version; vocabulary is the current entry
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchItemResult) Reset() {
+	*x = BatchItemResult{}
+	mi := &file_proto_vocabulary_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchItemResult) ProtoMessage() {}
+
+func (x *BatchItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchItemResult.ProtoReflect.Descriptor instead.
+func (*BatchItemResult) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *BatchItemResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchItemResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BatchItemResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BatchItemResult) GetVocabularyId() uint32 {
+	if x != nil {
+		return x.VocabularyId
+	}
+	return 0
+}
+
+func (x *BatchItemResult) GetVocabulary() *Vocabulary {
+	if x != nil {
+		return x.Vocabulary
+	}
+	return nil
+}
+
+func (x *BatchItemResult) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+func (x *BatchItemResult) GetMerged() bool {
+	if x != nil {
+		return x.Merged
+	}
+	return false
+}
+
+func (x *BatchItemResult) GetConflict() bool {
+	if x != nil {
+		return x.Conflict
+	}
+	return false
+}
+
+type ImportRowResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"` // Line number in the file; the header is line 1
+	Word          string                 `protobuf:"bytes,2,opt,name=word,proto3" json:"word,omitempty"`
+	Result        string                 `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`                                  // "created", "duplicate", "merged" or "failed"
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`                                // Why the row was skipped or failed
+	VocabularyId  uint32                 `protobuf:"varint,5,opt,name=vocabulary_id,json=vocabularyId,proto3" json:"vocabulary_id,omitempty"` // The created, merged or existing entry
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowResult) Reset() {
+	*x = ImportRowResult{}
+	mi := &file_proto_vocabulary_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowResult) ProtoMessage() {}
+
+func (x *ImportRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowResult.ProtoReflect.Descriptor instead.
+func (*ImportRowResult) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ImportRowResult) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowResult) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetVocabularyId() uint32 {
+	if x != nil {
+		return x.VocabularyId
+	}
+	return 0
+}
+
+type DailyCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD format
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyCount) Reset() {
+	*x = DailyCount{}
+	mi := &file_proto_vocabulary_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyCount) ProtoMessage() {}
+
+func (x *DailyCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyCount.ProtoReflect.Descriptor instead.
+func (*DailyCount) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *DailyCount) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailyCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ProgressPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`                                                                                                                // First day of the day or week (YYYY-MM-DD)
+	StatusCounts  map[string]int32       `protobuf:"bytes,2,rep,name=status_counts,json=statusCounts,proto3" json:"status_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Words in each status at the end of the day or week, every status included
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`                                                                                                             // Words at the end of the day or week
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProgressPoint) Reset() {
+	*x = ProgressPoint{}
+	mi := &file_proto_vocabulary_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProgressPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProgressPoint) ProtoMessage() {}
+
+func (x *ProgressPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProgressPoint.ProtoReflect.Descriptor instead.
+func (*ProgressPoint) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ProgressPoint) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ProgressPoint) GetStatusCounts() map[string]int32 {
+	if x != nil {
+		return x.StatusCounts
+	}
+	return nil
+}
+
+func (x *ProgressPoint) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ReviewAttempt struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	VocabularyId   uint32                 `protobuf:"varint,2,opt,name=vocabulary_id,json=vocabularyId,proto3" json:"vocabulary_id,omitempty"`
+	Grade          string                 `protobuf:"bytes,3,opt,name=grade,proto3" json:"grade,omitempty"`
+	ResponseTimeMs int32                  `protobuf:"varint,4,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+	ReviewedAt     string                 `protobuf:"bytes,5,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"` // RFC3339 format
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReviewAttempt) Reset() {
+	*x = ReviewAttempt{}
+	mi := &file_proto_vocabulary_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewAttempt) ProtoMessage() {}
+
+func (x *ReviewAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewAttempt.ProtoReflect.Descriptor instead.
+func (*ReviewAttempt) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ReviewAttempt) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReviewAttempt) GetVocabularyId() uint32 {
+	if x != nil {
+		return x.VocabularyId
+	}
+	return 0
+}
+
+func (x *ReviewAttempt) GetGrade() string {
+	if x != nil {
+		return x.Grade
+	}
+	return ""
+}
+
+func (x *ReviewAttempt) GetResponseTimeMs() int32 {
+	if x != nil {
+		return x.ResponseTimeMs
 	}
-	return false
+	return 0
+}
+
+func (x *ReviewAttempt) GetReviewedAt() string {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return ""
+}
+
+// One change to an entry, made by an update, a batch, an update by filter,
+// a merge or a revert
+type VocabularyRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	VocabularyId  uint32                 `protobuf:"varint,2,opt,name=vocabulary_id,json=vocabularyId,proto3" json:"vocabulary_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // User who made the change
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`                // "update", "bulk_update", "merge", "revert", "review" or "quiz"
+	Changes       []*FieldChange         `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339 format
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyRevision) Reset() {
+	*x = VocabularyRevision{}
+	mi := &file_proto_vocabulary_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyRevision) ProtoMessage() {}
+
+func (x *VocabularyRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyRevision.ProtoReflect.Descriptor instead.
+func (*VocabularyRevision) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *VocabularyRevision) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VocabularyRevision) GetVocabularyId() uint32 {
+	if x != nil {
+		return x.VocabularyId
+	}
+	return 0
+}
+
+func (x *VocabularyRevision) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *VocabularyRevision) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *VocabularyRevision) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *VocabularyRevision) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type FieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"` // "word", "meaning", "example" or "status"
+	OldValue      string                 `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue      string                 `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_proto_vocabulary_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *FieldChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+type HardWord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VocabularyId  uint32                 `protobuf:"varint,1,opt,name=vocabulary_id,json=vocabularyId,proto3" json:"vocabulary_id,omitempty"`
+	Word          string                 `protobuf:"bytes,2,opt,name=word,proto3" json:"word,omitempty"`
+	Attempts      int32                  `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Failures      int32                  `protobuf:"varint,4,opt,name=failures,proto3" json:"failures,omitempty"` // Attempts graded "again"
+	AccuracyRate  float64                `protobuf:"fixed64,5,opt,name=accuracy_rate,json=accuracyRate,proto3" json:"accuracy_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HardWord) Reset() {
+	*x = HardWord{}
+	mi := &file_proto_vocabulary_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HardWord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HardWord) ProtoMessage() {}
+
+func (x *HardWord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HardWord.ProtoReflect.Descriptor instead.
+func (*HardWord) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *HardWord) GetVocabularyId() uint32 {
+	if x != nil {
+		return x.VocabularyId
+	}
+	return 0
+}
+
+func (x *HardWord) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *HardWord) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *HardWord) GetFailures() int32 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *HardWord) GetAccuracyRate() float64 {
+	if x != nil {
+		return x.AccuracyRate
+	}
+	return 0
+}
+
+type QuizQuestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VocabularyId  uint32                 `protobuf:"varint,1,opt,name=vocabulary_id,json=vocabularyId,proto3" json:"vocabulary_id,omitempty"`
+	Prompt        string                 `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Options       []string               `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizQuestion) Reset() {
+	*x = QuizQuestion{}
+	mi := &file_proto_vocabulary_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizQuestion) ProtoMessage() {}
+
+func (x *QuizQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizQuestion.ProtoReflect.Descriptor instead.
+func (*QuizQuestion) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *QuizQuestion) GetVocabularyId() uint32 {
+	if x != nil {
+		return x.VocabularyId
+	}
+	return 0
+}
+
+func (x *QuizQuestion) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *QuizQuestion) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type QuizAnswer struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	VocabularyId   uint32                 `protobuf:"varint,1,opt,name=vocabulary_id,json=vocabularyId,proto3" json:"vocabulary_id,omitempty"`
+	Answer         string                 `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`
+	ResponseTimeMs int32                  `protobuf:"varint,3,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"` // Optional
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *QuizAnswer) Reset() {
+	*x = QuizAnswer{}
+	mi := &file_proto_vocabulary_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizAnswer) ProtoMessage() {}
+
+func (x *QuizAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizAnswer.ProtoReflect.Descriptor instead.
+func (*QuizAnswer) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *QuizAnswer) GetVocabularyId() uint32 {
+	if x != nil {
+		return x.VocabularyId
+	}
+	return 0
+}
+
+func (x *QuizAnswer) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *QuizAnswer) GetResponseTimeMs() int32 {
+	if x != nil {
+		return x.ResponseTimeMs
+	}
+	return 0
+}
+
+type QuizResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VocabularyId  uint32                 `protobuf:"varint,1,opt,name=vocabulary_id,json=vocabularyId,proto3" json:"vocabulary_id,omitempty"`
+	Correct       bool                   `protobuf:"varint,2,opt,name=correct,proto3" json:"correct,omitempty"`
+	Answer        string                 `protobuf:"bytes,3,opt,name=answer,proto3" json:"answer,omitempty"`
+	CorrectAnswer string                 `protobuf:"bytes,4,opt,name=correct_answer,json=correctAnswer,proto3" json:"correct_answer,omitempty"`
+	Vocabulary    *Vocabulary            `protobuf:"bytes,5,opt,name=vocabulary,proto3" json:"vocabulary,omitempty"` // Entry after its schedule was updated
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizResult) Reset() {
+	*x = QuizResult{}
+	mi := &file_proto_vocabulary_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *VocabularyStatsResponse) GetMessage() string {
+func (x *QuizResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizResult) ProtoMessage() {}
+
+func (x *QuizResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[104]
 	if x != nil {
-		return x.Message
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *VocabularyStatsResponse) GetTotalWords() int32 {
+// Deprecated: Use QuizResult.ProtoReflect.Descriptor instead.
+func (*QuizResult) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *QuizResult) GetVocabularyId() uint32 {
 	if x != nil {
-		return x.TotalWords
+		return x.VocabularyId
 	}
 	return 0
 }
 
-func (x *VocabularyStatsResponse) GetWordsThisWeek() int32 {
+func (x *QuizResult) GetCorrect() bool {
 	if x != nil {
-		return x.WordsThisWeek
+		return x.Correct
 	}
-	return 0
+	return false
 }
 
-func (x *VocabularyStatsResponse) GetWordsThisMonth() int32 {
+func (x *QuizResult) GetAnswer() string {
 	if x != nil {
-		return x.WordsThisMonth
+		return x.Answer
 	}
-	return 0
+	return ""
 }
 
-func (x *VocabularyStatsResponse) GetStatusCounts() map[string]int32 {
+func (x *QuizResult) GetCorrectAnswer() string {
 	if x != nil {
-		return x.StatusCounts
+		return x.CorrectAnswer
 	}
-	return nil
+	return ""
 }
 
-func (x *VocabularyStatsResponse) GetDailyCounts() []*DailyCount {
+func (x *QuizResult) GetVocabulary() *Vocabulary {
 	if x != nil {
-		return x.DailyCounts
+		return x.Vocabulary
 	}
 	return nil
 }
 
-// Data models
-type Vocabulary struct {
+type ClozeItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Word          string                 `protobuf:"bytes,3,opt,name=word,proto3" json:"word,omitempty"`
-	Meaning       string                 `protobuf:"bytes,4,opt,name=meaning,proto3" json:"meaning,omitempty"`
-	Example       string                 `protobuf:"bytes,5,opt,name=example,proto3" json:"example,omitempty"`
-	Date          string                 `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`                            // YYYY-MM-DD format
-	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`                        // "review_needed", "learned", "mastered"
-	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339 format
-	UpdatedAt     string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // RFC3339 format
+	VocabularyId  uint32                 `protobuf:"varint,1,opt,name=vocabulary_id,json=vocabularyId,proto3" json:"vocabulary_id,omitempty"`
+	Sentence      string                 `protobuf:"bytes,2,opt,name=sentence,proto3" json:"sentence,omitempty"`                              // Example sentence with the word blanked out
+	Hint          string                 `protobuf:"bytes,3,opt,name=hint,proto3" json:"hint,omitempty"`                                      // The word's meaning
+	AnswerLength  int32                  `protobuf:"varint,4,opt,name=answer_length,json=answerLength,proto3" json:"answer_length,omitempty"` // Number of characters in the blanked form
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Vocabulary) Reset() {
-	*x = Vocabulary{}
-	mi := &file_proto_vocabulary_proto_msgTypes[10]
+func (x *ClozeItem) Reset() {
+	*x = ClozeItem{}
+	mi := &file_proto_vocabulary_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Vocabulary) String() string {
+func (x *ClozeItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Vocabulary) ProtoMessage() {}
+func (*ClozeItem) ProtoMessage() {}
 
-func (x *Vocabulary) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_vocabulary_proto_msgTypes[10]
+func (x *ClozeItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -752,97 +7525,178 @@ func (x *Vocabulary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Vocabulary.ProtoReflect.Descriptor instead.
-func (*Vocabulary) Descriptor() ([]byte, []int) {
-	return file_proto_vocabulary_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ClozeItem.ProtoReflect.Descriptor instead.
+func (*ClozeItem) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *Vocabulary) GetId() uint32 {
+func (x *ClozeItem) GetVocabularyId() uint32 {
 	if x != nil {
-		return x.Id
+		return x.VocabularyId
 	}
 	return 0
 }
 
-func (x *Vocabulary) GetUserId() uint32 {
+func (x *ClozeItem) GetSentence() string {
 	if x != nil {
-		return x.UserId
+		return x.Sentence
 	}
-	return 0
+	return ""
 }
 
-func (x *Vocabulary) GetWord() string {
+func (x *ClozeItem) GetHint() string {
 	if x != nil {
-		return x.Word
+		return x.Hint
 	}
 	return ""
 }
 
-func (x *Vocabulary) GetMeaning() string {
+func (x *ClozeItem) GetAnswerLength() int32 {
 	if x != nil {
-		return x.Meaning
+		return x.AnswerLength
 	}
-	return ""
+	return 0
 }
 
-func (x *Vocabulary) GetExample() string {
+type ClozeIssue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VocabularyId  uint32                 `protobuf:"varint,1,opt,name=vocabulary_id,json=vocabularyId,proto3" json:"vocabulary_id,omitempty"`
+	Word          string                 `protobuf:"bytes,2,opt,name=word,proto3" json:"word,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClozeIssue) Reset() {
+	*x = ClozeIssue{}
+	mi := &file_proto_vocabulary_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClozeIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClozeIssue) ProtoMessage() {}
+
+func (x *ClozeIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[106]
 	if x != nil {
-		return x.Example
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Vocabulary) GetDate() string {
+// Deprecated: Use ClozeIssue.ProtoReflect.Descriptor instead.
+func (*ClozeIssue) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ClozeIssue) GetVocabularyId() uint32 {
 	if x != nil {
-		return x.Date
+		return x.VocabularyId
 	}
-	return ""
+	return 0
 }
 
-func (x *Vocabulary) GetStatus() string {
+func (x *ClozeIssue) GetWord() string {
 	if x != nil {
-		return x.Status
+		return x.Word
 	}
 	return ""
 }
 
-func (x *Vocabulary) GetCreatedAt() string {
+func (x *ClozeIssue) GetReason() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *Vocabulary) GetUpdatedAt() string {
+type ClozeAnswer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VocabularyId  uint32                 `protobuf:"varint,1,opt,name=vocabulary_id,json=vocabularyId,proto3" json:"vocabulary_id,omitempty"`
+	Answer        string                 `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClozeAnswer) Reset() {
+	*x = ClozeAnswer{}
+	mi := &file_proto_vocabulary_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClozeAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClozeAnswer) ProtoMessage() {}
+
+func (x *ClozeAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[107]
 	if x != nil {
-		return x.UpdatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClozeAnswer.ProtoReflect.Descriptor instead.
+func (*ClozeAnswer) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ClozeAnswer) GetVocabularyId() uint32 {
+	if x != nil {
+		return x.VocabularyId
+	}
+	return 0
+}
+
+func (x *ClozeAnswer) GetAnswer() string {
+	if x != nil {
+		return x.Answer
 	}
 	return ""
 }
 
-type DailyCount struct {
+type ClozeResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD format
-	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	VocabularyId  uint32                 `protobuf:"varint,1,opt,name=vocabulary_id,json=vocabularyId,proto3" json:"vocabulary_id,omitempty"`
+	Correct       bool                   `protobuf:"varint,2,opt,name=correct,proto3" json:"correct,omitempty"`
+	Exact         bool                   `protobuf:"varint,3,opt,name=exact,proto3" json:"exact,omitempty"` // False when accepted with a typo
+	Answer        string                 `protobuf:"bytes,4,opt,name=answer,proto3" json:"answer,omitempty"`
+	Expected      string                 `protobuf:"bytes,5,opt,name=expected,proto3" json:"expected,omitempty"`
+	Distance      int32                  `protobuf:"varint,6,opt,name=distance,proto3" json:"distance,omitempty"` // Edit distance between answer and expected
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DailyCount) Reset() {
-	*x = DailyCount{}
-	mi := &file_proto_vocabulary_proto_msgTypes[11]
+func (x *ClozeResult) Reset() {
+	*x = ClozeResult{}
+	mi := &file_proto_vocabulary_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DailyCount) String() string {
+func (x *ClozeResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DailyCount) ProtoMessage() {}
+func (*ClozeResult) ProtoMessage() {}
 
-func (x *DailyCount) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_vocabulary_proto_msgTypes[11]
+func (x *ClozeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vocabulary_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -853,21 +7707,49 @@ func (x *DailyCount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DailyCount.ProtoReflect.Descriptor instead.
-func (*DailyCount) Descriptor() ([]byte, []int) {
-	return file_proto_vocabulary_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ClozeResult.ProtoReflect.Descriptor instead.
+func (*ClozeResult) Descriptor() ([]byte, []int) {
+	return file_proto_vocabulary_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *DailyCount) GetDate() string {
+func (x *ClozeResult) GetVocabularyId() uint32 {
 	if x != nil {
-		return x.Date
+		return x.VocabularyId
+	}
+	return 0
+}
+
+func (x *ClozeResult) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+func (x *ClozeResult) GetExact() bool {
+	if x != nil {
+		return x.Exact
+	}
+	return false
+}
+
+func (x *ClozeResult) GetAnswer() string {
+	if x != nil {
+		return x.Answer
 	}
 	return ""
 }
 
-func (x *DailyCount) GetCount() int32 {
+func (x *ClozeResult) GetExpected() string {
 	if x != nil {
-		return x.Count
+		return x.Expected
+	}
+	return ""
+}
+
+func (x *ClozeResult) GetDistance() int32 {
+	if x != nil {
+		return x.Distance
 	}
 	return 0
 }
@@ -877,52 +7759,438 @@ var File_proto_vocabulary_proto protoreflect.FileDescriptor
 const file_proto_vocabulary_proto_rawDesc = "" +
 	"\n" +
 	"\x16proto/vocabulary.proto\x12\n" +
-	"vocabulary\"\x8b\x01\n" +
+	"vocabulary\x1a google/protobuf/field_mask.proto\"\xd2\x02\n" +
 	"\x16GetVocabulariesRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x12\n" +
 	"\x04date\x18\x02 \x01(\tR\x04date\x12\x16\n" +
 	"\x06search\x18\x03 \x01(\tR\x06search\x12\x14\n" +
 	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x05 \x01(\x05R\x06offset\"\xa6\x01\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offset\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12\x1b\n" +
+	"\ttag_match\x18\a \x01(\tR\btagMatch\x12\x17\n" +
+	"\adeck_id\x18\b \x01(\rR\x06deckId\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\f\n" +
+	"\x01q\x18\n" +
+	" \x01(\tR\x01q\x12\x17\n" +
+	"\asort_by\x18\v \x01(\tR\x06sortBy\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\f \x01(\tR\tsortOrder\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\r \x01(\tR\tpageToken\"\xf8\x01\n" +
 	"\x17CreateVocabularyRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x12\n" +
 	"\x04word\x18\x02 \x01(\tR\x04word\x12\x18\n" +
 	"\ameaning\x18\x03 \x01(\tR\ameaning\x12\x18\n" +
 	"\aexample\x18\x04 \x01(\tR\aexample\x12\x12\n" +
 	"\x04date\x18\x05 \x01(\tR\x04date\x12\x16\n" +
-	"\x06status\x18\x06 \x01(\tR\x06status\"\xb7\x01\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12\x19\n" +
+	"\bdeck_ids\x18\b \x03(\rR\adeckIds\x12!\n" +
+	"\fon_duplicate\x18\t \x01(\tR\vonDuplicate\"\xd2\x02\n" +
 	"\x17UpdateVocabularyRequest\x12#\n" +
 	"\rvocabulary_id\x18\x01 \x01(\rR\fvocabularyId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x12\n" +
 	"\x04word\x18\x03 \x01(\tR\x04word\x12\x18\n" +
 	"\ameaning\x18\x04 \x01(\tR\ameaning\x12\x18\n" +
 	"\aexample\x18\x05 \x01(\tR\aexample\x12\x16\n" +
-	"\x06status\x18\x06 \x01(\tR\x06status\"W\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12\x1d\n" +
+	"\n" +
+	"clear_tags\x18\b \x01(\bR\tclearTags\x12)\n" +
+	"\x10expected_version\x18\t \x01(\x05R\x0fexpectedVersion\x12;\n" +
+	"\vupdate_mask\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"W\n" +
 	"\x17DeleteVocabularyRequest\x12#\n" +
 	"\rvocabulary_id\x18\x01 \x01(\rR\fvocabularyId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\rR\x06userId\"X\n" +
 	"\x18GetVocabularyByIdRequest\x12#\n" +
 	"\rvocabulary_id\x18\x01 \x01(\rR\fvocabularyId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\rR\x06userId\"j\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"\x97\x01\n" +
+	"\x17ReviewVocabularyRequest\x12#\n" +
+	"\rvocabulary_id\x18\x01 \x01(\rR\fvocabularyId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x14\n" +
+	"\x05grade\x18\x03 \x01(\tR\x05grade\x12(\n" +
+	"\x10response_time_ms\x18\x04 \x01(\x05R\x0eresponseTimeMs\"\x8d\x01\n" +
+	"\x19GetDueVocabulariesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x1b\n" +
+	"\tnew_limit\x18\x02 \x01(\x05R\bnewLimit\x12!\n" +
+	"\freview_limit\x18\x03 \x01(\x05R\vreviewLimit\x12\x17\n" +
+	"\adeck_id\x18\x04 \x01(\rR\x06deckId\"m\n" +
+	"\x17GetReviewHistoryRequest\x12#\n" +
+	"\rvocabulary_id\x18\x01 \x01(\rR\fvocabularyId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\xe4\x01\n" +
+	"\x13GenerateQuizRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12%\n" +
+	"\x0equestion_count\x18\x02 \x01(\x05R\rquestionCount\x12\x1c\n" +
+	"\tdirection\x18\x03 \x01(\tR\tdirection\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1b\n" +
+	"\tdate_from\x18\x05 \x01(\tR\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\x06 \x01(\tR\x06dateTo\x12!\n" +
+	"\fchoice_count\x18\a \x01(\x05R\vchoiceCount\"\x95\x01\n" +
+	"\x11SubmitQuizRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x1c\n" +
+	"\tdirection\x18\x02 \x01(\tR\tdirection\x120\n" +
+	"\aanswers\x18\x03 \x03(\v2\x16.vocabulary.QuizAnswerR\aanswers\x12\x17\n" +
+	"\aquiz_id\x18\x04 \x01(\rR\x06quizId\"]\n" +
+	"\x14GenerateClozeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"_\n" +
+	"\x11GradeClozeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x121\n" +
+	"\aanswers\x18\x02 \x03(\v2\x17.vocabulary.ClozeAnswerR\aanswers\"M\n" +
+	"\x13SetDailyGoalRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x1d\n" +
+	"\n" +
+	"daily_goal\x18\x02 \x01(\x05R\tdailyGoal\"*\n" +
+	"\x0fListTagsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\"V\n" +
+	"\x10RenameTagRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x15\n" +
+	"\x06tag_id\x18\x02 \x01(\rR\x05tagId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"B\n" +
+	"\x10DeleteTagRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x15\n" +
+	"\x06tag_id\x18\x02 \x01(\rR\x05tagId\"\x90\x02\n" +
+	"\x11CreateDeckRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12'\n" +
+	"\x0fsource_language\x18\x04 \x01(\tR\x0esourceLanguage\x12'\n" +
+	"\x0ftarget_language\x18\x05 \x01(\tR\x0etargetLanguage\x12)\n" +
+	"\x11new_cards_per_day\x18\x06 \x01(\x05R\x0enewCardsPerDay\x12/\n" +
+	"\x14review_cards_per_day\x18\a \x01(\x05R\x11reviewCardsPerDay\"*\n" +
+	"\x0fGetDecksRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\"?\n" +
+	"\vDeckRequest\x12\x17\n" +
+	"\adeck_id\x18\x01 \x01(\rR\x06deckId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"\xe2\x02\n" +
+	"\x11UpdateDeckRequest\x12\x17\n" +
+	"\adeck_id\x18\x01 \x01(\rR\x06deckId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12'\n" +
+	"\x0fsource_language\x18\x05 \x01(\tR\x0esourceLanguage\x12'\n" +
+	"\x0ftarget_language\x18\x06 \x01(\tR\x0etargetLanguage\x12.\n" +
+	"\x11new_cards_per_day\x18\a \x01(\x05H\x00R\x0enewCardsPerDay\x88\x01\x01\x124\n" +
+	"\x14review_cards_per_day\x18\b \x01(\x05H\x01R\x11reviewCardsPerDay\x88\x01\x01B\x14\n" +
+	"\x12_new_cards_per_dayB\x17\n" +
+	"\x15_review_cards_per_day\"r\n" +
+	"\x17DeckVocabulariesRequest\x12\x17\n" +
+	"\adeck_id\x18\x01 \x01(\rR\x06deckId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12%\n" +
+	"\x0evocabulary_ids\x18\x03 \x03(\rR\rvocabularyIds\"\xa9\x01\n" +
+	"\x1bTransferVocabulariesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12$\n" +
+	"\x0esource_deck_id\x18\x02 \x01(\rR\fsourceDeckId\x12$\n" +
+	"\x0etarget_deck_id\x18\x03 \x01(\rR\ftargetDeckId\x12%\n" +
+	"\x0evocabulary_ids\x18\x04 \x03(\rR\rvocabularyIds\"0\n" +
+	"\x15FindDuplicatesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\"o\n" +
+	"\x18MergeVocabulariesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\rR\btargetId\x12\x1d\n" +
+	"\n" +
+	"source_ids\x18\x03 \x03(\rR\tsourceIds\"\x85\x01\n" +
+	"\x19ImportVocabulariesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12!\n" +
+	"\fon_duplicate\x18\x03 \x01(\tR\vonDuplicate\x12\x14\n" +
+	"\x05chunk\x18\x04 \x01(\fR\x05chunk\"\x88\x01\n" +
+	"\x19ExportVocabulariesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12:\n" +
+	"\x06filter\x18\x03 \x01(\v2\".vocabulary.GetVocabulariesRequestR\x06filter\"e\n" +
+	"\x14BackupAccountRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x124\n" +
+	"\aprofile\x18\x02 \x01(\v2\x1a.vocabulary.AccountProfileR\aprofile\"^\n" +
+	"\x15RestoreAccountRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x18\n" +
+	"\aarchive\x18\x02 \x01(\fR\aarchive\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\tR\x04mode\"p\n" +
+	"\x13SuggestWordsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\tR\x06prefix\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\tR\x04sort\"\x83\x01\n" +
+	"\x18CreateSavedSearchRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12:\n" +
+	"\x06filter\x18\x03 \x01(\v2\".vocabulary.GetVocabulariesRequestR\x06filter\"2\n" +
+	"\x17GetSavedSearchesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\"\xab\x01\n" +
+	"\x18UpdateSavedSearchRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12&\n" +
+	"\x0fsaved_search_id\x18\x02 \x01(\rR\rsavedSearchId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12:\n" +
+	"\x06filter\x18\x04 \x01(\v2\".vocabulary.GetVocabulariesRequestR\x06filter\"U\n" +
+	"\x12SavedSearchRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12&\n" +
+	"\x0fsaved_search_id\x18\x02 \x01(\rR\rsavedSearchId\"\xe4\x01\n" +
+	"\x1cGetSavedSearchResultsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12&\n" +
+	"\x0fsaved_search_id\x18\x02 \x01(\rR\rsavedSearchId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\x12\x17\n" +
+	"\asort_by\x18\x05 \x01(\tR\x06sortBy\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x06 \x01(\tR\tsortOrder\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\"\x88\x01\n" +
+	"\x1eBatchCreateVocabulariesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x129\n" +
+	"\x05items\x18\x02 \x03(\v2#.vocabulary.CreateVocabularyRequestR\x05items\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\tR\x04mode\"\x88\x01\n" +
+	"\x1eBatchUpdateVocabulariesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x129\n" +
+	"\x05items\x18\x02 \x03(\v2#.vocabulary.UpdateVocabularyRequestR\x05items\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\tR\x04mode\"t\n" +
+	"\x1eBatchDeleteVocabulariesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12%\n" +
+	"\x0evocabulary_ids\x18\x02 \x03(\rR\rvocabularyIds\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\tR\x04mode\"\xeb\x01\n" +
+	"!UpdateVocabulariesByFilterRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12:\n" +
+	"\x06filter\x18\x02 \x01(\v2\".vocabulary.GetVocabulariesRequestR\x06filter\x127\n" +
+	"\achanges\x18\x03 \x01(\v2\x1d.vocabulary.VocabularyChangesR\achanges\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\x12\x1f\n" +
+	"\vsample_size\x18\x05 \x01(\x05R\n" +
+	"sampleSize\"Y\n" +
+	"\x10ListTrashRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"X\n" +
+	"\x18RestoreVocabularyRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12#\n" +
+	"\rvocabulary_id\x18\x02 \x01(\rR\fvocabularyId\"S\n" +
+	"\x11PurgeTrashRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12%\n" +
+	"\x0evocabulary_ids\x18\x02 \x03(\rR\rvocabularyIds\"\x8b\x01\n" +
+	"\x1dGetVocabularyRevisionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12#\n" +
+	"\rvocabulary_id\x18\x02 \x01(\rR\fvocabularyId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"x\n" +
+	"\x17RevertVocabularyRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12#\n" +
+	"\rvocabulary_id\x18\x02 \x01(\rR\fvocabularyId\x12\x1f\n" +
+	"\vrevision_id\x18\x03 \x01(\rR\n" +
+	"revisionId\"1\n" +
+	"\x16GetStatusStagesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\"b\n" +
+	"\x16SetStatusStagesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12/\n" +
+	"\x06stages\x18\x02 \x03(\v2\x17.vocabulary.StatusStageR\x06stages\"\x89\x01\n" +
+	"\x1bGetStatusTransitionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12#\n" +
+	"\rvocabulary_id\x18\x02 \x01(\rR\fvocabularyId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"\x87\x01\n" +
+	"\x1aGetProgressTimelineRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x1b\n" +
+	"\tdate_from\x18\x02 \x01(\tR\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\x03 \x01(\tR\x06dateTo\x12\x1a\n" +
+	"\binterval\x18\x04 \x01(\tR\binterval\"j\n" +
 	"\x19GetVocabularyStatsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x1b\n" +
 	"\tdate_from\x18\x02 \x01(\tR\bdateFrom\x12\x17\n" +
-	"\adate_to\x18\x03 \x01(\tR\x06dateTo\"\xb5\x01\n" +
+	"\adate_to\x18\x03 \x01(\tR\x06dateTo\"\x84\x02\n" +
 	"\x17GetVocabulariesResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
 	"\fvocabularies\x18\x03 \x03(\v2\x16.vocabulary.VocabularyR\fvocabularies\x12\x14\n" +
 	"\x05count\x18\x04 \x01(\x05R\x05count\x12\x14\n" +
-	"\x05total\x18\x05 \x01(\x05R\x05total\"\x80\x01\n" +
+	"\x05total\x18\x05 \x01(\x05R\x05total\x12%\n" +
+	"\x0eerror_position\x18\x06 \x01(\x05R\rerrorPosition\x12&\n" +
+	"\x0fnext_page_token\x18\a \x01(\tR\rnextPageToken\"\xe9\x01\n" +
+	"\x1aGetDueVocabulariesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
+	"\fvocabularies\x18\x03 \x03(\v2\x16.vocabulary.VocabularyR\fvocabularies\x12!\n" +
+	"\freview_count\x18\x04 \x01(\x05R\vreviewCount\x12\x1b\n" +
+	"\tnew_count\x18\x05 \x01(\x05R\bnewCount\x12\x1b\n" +
+	"\ttotal_due\x18\x06 \x01(\x05R\btotalDue\"\xc0\x01\n" +
+	"\x18GetReviewHistoryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x125\n" +
+	"\battempts\x18\x03 \x03(\v2\x19.vocabulary.ReviewAttemptR\battempts\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\x12#\n" +
+	"\raccuracy_rate\x18\x05 \x01(\x01R\faccuracyRate\"\xb9\x01\n" +
+	"\x14GenerateQuizResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\tdirection\x18\x03 \x01(\tR\tdirection\x126\n" +
+	"\tquestions\x18\x04 \x03(\v2\x18.vocabulary.QuizQuestionR\tquestions\x12\x17\n" +
+	"\aquiz_id\x18\x05 \x01(\rR\x06quizId\"\xc0\x01\n" +
+	"\x12SubmitQuizResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\acorrect\x18\x03 \x01(\x05R\acorrect\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\x12\x14\n" +
+	"\x05score\x18\x05 \x01(\x01R\x05score\x120\n" +
+	"\aresults\x18\x06 \x03(\v2\x16.vocabulary.QuizResultR\aresults\"\xac\x01\n" +
+	"\x15GenerateClozeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12+\n" +
+	"\x05items\x18\x03 \x03(\v2\x15.vocabulary.ClozeItemR\x05items\x122\n" +
+	"\bunusable\x18\x04 \x03(\v2\x16.vocabulary.ClozeIssueR\bunusable\"\xab\x01\n" +
+	"\x12GradeClozeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\acorrect\x18\x03 \x01(\x05R\acorrect\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\x121\n" +
+	"\aresults\x18\x05 \x03(\v2\x17.vocabulary.ClozeResultR\aresults\"f\n" +
+	"\x11DailyGoalResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"daily_goal\x18\x03 \x01(\x05R\tdailyGoal\"k\n" +
+	"\x10ListTagsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12#\n" +
+	"\x04tags\x18\x03 \x03(\v2\x0f.vocabulary.TagR\x04tags\"d\n" +
+	"\vTagResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12!\n" +
+	"\x03tag\x18\x03 \x01(\v2\x0f.vocabulary.TagR\x03tag\"G\n" +
+	"\x11DeleteTagResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"h\n" +
+	"\fDeckResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12$\n" +
+	"\x04deck\x18\x03 \x01(\v2\x10.vocabulary.DeckR\x04deck\"n\n" +
+	"\x10GetDecksResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
+	"\x05decks\x18\x03 \x03(\v2\x10.vocabulary.DeckR\x05decks\"H\n" +
+	"\x12DeleteDeckResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"j\n" +
+	"\x18DeckVocabulariesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1a\n" +
+	"\baffected\x18\x03 \x01(\x05R\baffected\"\xa9\x03\n" +
+	"\x11DeckStatsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12$\n" +
+	"\x04deck\x18\x03 \x01(\v2\x10.vocabulary.DeckR\x04deck\x12\x1f\n" +
+	"\vtotal_words\x18\x04 \x01(\x05R\n" +
+	"totalWords\x12T\n" +
+	"\rstatus_counts\x18\x05 \x03(\v2/.vocabulary.DeckStatsResponse.StatusCountsEntryR\fstatusCounts\x12\x1b\n" +
+	"\tdue_count\x18\x06 \x01(\x05R\bdueCount\x12\x1b\n" +
+	"\tnew_count\x18\a \x01(\x05R\bnewCount\x12#\n" +
+	"\rtotal_reviews\x18\b \x01(\x05R\ftotalReviews\x12#\n" +
+	"\raccuracy_rate\x18\t \x01(\x01R\faccuracyRate\x1a?\n" +
+	"\x11StatusCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\x80\x01\n" +
+	"\x16FindDuplicatesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x122\n" +
+	"\x06groups\x18\x03 \x03(\v2\x1a.vocabulary.DuplicateGroupR\x06groups\"\xeb\x01\n" +
+	"\x1aImportVocabulariesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\acreated\x18\x03 \x01(\x05R\acreated\x12\x1e\n" +
+	"\n" +
+	"duplicates\x18\x04 \x01(\x05R\n" +
+	"duplicates\x12\x16\n" +
+	"\x06merged\x18\x05 \x01(\x05R\x06merged\x12\x16\n" +
+	"\x06failed\x18\x06 \x01(\x05R\x06failed\x12/\n" +
+	"\x04rows\x18\a \x03(\v2\x1b.vocabulary.ImportRowResultR\x04rows\"f\n" +
+	"\x1aExportVocabulariesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
+	"\x05chunk\x18\x03 \x01(\fR\x05chunk\"\x86\x01\n" +
+	"\x15BackupAccountResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\aarchive\x18\x03 \x01(\fR\aarchive\x12\x1f\n" +
+	"\vtotal_words\x18\x04 \x01(\x05R\n" +
+	"totalWords\"\xd0\x01\n" +
+	"\x16RestoreAccountResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\acreated\x18\x03 \x01(\x05R\acreated\x12\x18\n" +
+	"\askipped\x18\x04 \x01(\x05R\askipped\x12\x18\n" +
+	"\adeleted\x18\x05 \x01(\x05R\adeleted\x124\n" +
+	"\aprofile\x18\x06 \x01(\v2\x1a.vocabulary.AccountProfileR\aprofile\"\x88\x01\n" +
+	"\x14SuggestWordsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12<\n" +
+	"\vsuggestions\x18\x03 \x03(\v2\x1a.vocabulary.WordSuggestionR\vsuggestions\"\x85\x01\n" +
+	"\x13SavedSearchResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
+	"\fsaved_search\x18\x03 \x01(\v2\x17.vocabulary.SavedSearchR\vsavedSearch\"\x8e\x01\n" +
+	"\x18GetSavedSearchesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12>\n" +
+	"\x0esaved_searches\x18\x03 \x03(\v2\x17.vocabulary.SavedSearchR\rsavedSearches\"O\n" +
+	"\x19DeleteSavedSearchResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x96\x02\n" +
+	"\"UpdateVocabulariesByFilterResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\amatched\x18\x03 \x01(\x05R\amatched\x12\x18\n" +
+	"\aupdated\x18\x04 \x01(\x05R\aupdated\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\x12.\n" +
+	"\x06sample\x18\x06 \x03(\v2\x16.vocabulary.VocabularyR\x06sample\x12%\n" +
+	"\x0eerror_position\x18\a \x01(\x05R\rerrorPosition\x12\x18\n" +
+	"\askipped\x18\b \x01(\x05R\askipped\"\xc0\x01\n" +
+	"\x11ListTrashResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
+	"\fvocabularies\x18\x03 \x03(\v2\x16.vocabulary.VocabularyR\fvocabularies\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\x12%\n" +
+	"\x0eretention_days\x18\x05 \x01(\x05R\rretentionDays\"`\n" +
+	"\x12PurgeTrashResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06purged\x18\x03 \x01(\x05R\x06purged\"\xa8\x01\n" +
+	"\x1eGetVocabularyRevisionsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12<\n" +
+	"\trevisions\x18\x03 \x03(\v2\x1e.vocabulary.VocabularyRevisionR\trevisions\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\"\xad\x01\n" +
+	"\x14StatusStagesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12/\n" +
+	"\x06stages\x18\x03 \x03(\v2\x17.vocabulary.StatusStageR\x06stages\x12\x16\n" +
+	"\x06custom\x18\x04 \x01(\bR\x06custom\x12\x18\n" +
+	"\aupdated\x18\x05 \x01(\x05R\aupdated\"\xa8\x01\n" +
+	"\x1cGetStatusTransitionsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12>\n" +
+	"\vtransitions\x18\x03 \x03(\v2\x1c.vocabulary.StatusTransitionR\vtransitions\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\"\xa0\x01\n" +
+	"\x1bGetProgressTimelineResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1a\n" +
+	"\binterval\x18\x03 \x01(\tR\binterval\x121\n" +
+	"\x06points\x18\x04 \x03(\v2\x19.vocabulary.ProgressPointR\x06points\"\xbc\x01\n" +
+	"\x19BatchVocabulariesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x125\n" +
+	"\aresults\x18\x03 \x03(\v2\x1b.vocabulary.BatchItemResultR\aresults\x12\x1c\n" +
+	"\tsucceeded\x18\x04 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x05R\x06failed\"\xd2\x01\n" +
 	"\x12VocabularyResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x126\n" +
 	"\n" +
 	"vocabulary\x18\x03 \x01(\v2\x16.vocabulary.VocabularyR\n" +
-	"vocabulary\"N\n" +
+	"vocabulary\x12\x1c\n" +
+	"\tduplicate\x18\x04 \x01(\bR\tduplicate\x12\x16\n" +
+	"\x06merged\x18\x05 \x01(\bR\x06merged\x12\x1a\n" +
+	"\bconflict\x18\x06 \x01(\bR\bconflict\"N\n" +
 	"\x18DeleteVocabularyResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x98\x03\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x83\a\n" +
 	"\x17VocabularyStatsResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1f\n" +
@@ -931,10 +8199,24 @@ const file_proto_vocabulary_proto_rawDesc = "" +
 	"\x0fwords_this_week\x18\x04 \x01(\x05R\rwordsThisWeek\x12(\n" +
 	"\x10words_this_month\x18\x05 \x01(\x05R\x0ewordsThisMonth\x12Z\n" +
 	"\rstatus_counts\x18\x06 \x03(\v25.vocabulary.VocabularyStatsResponse.StatusCountsEntryR\fstatusCounts\x129\n" +
-	"\fdaily_counts\x18\a \x03(\v2\x16.vocabulary.DailyCountR\vdailyCounts\x1a?\n" +
+	"\fdaily_counts\x18\a \x03(\v2\x16.vocabulary.DailyCountR\vdailyCounts\x12#\n" +
+	"\rtotal_reviews\x18\b \x01(\x05R\ftotalReviews\x12#\n" +
+	"\raccuracy_rate\x18\t \x01(\x01R\faccuracyRate\x127\n" +
+	"\x18average_response_time_ms\x18\n" +
+	" \x01(\x01R\x15averageResponseTimeMs\x129\n" +
+	"\rhardest_words\x18\v \x03(\v2\x14.vocabulary.HardWordR\fhardestWords\x12%\n" +
+	"\x0ecurrent_streak\x18\f \x01(\x05R\rcurrentStreak\x12%\n" +
+	"\x0elongest_streak\x18\r \x01(\x05R\rlongestStreak\x12\x1d\n" +
+	"\n" +
+	"daily_goal\x18\x0e \x01(\x05R\tdailyGoal\x12%\n" +
+	"\x0etoday_progress\x18\x0f \x01(\x05R\rtodayProgress\x12W\n" +
+	"\fstage_counts\x18\x10 \x03(\v24.vocabulary.VocabularyStatsResponse.StageCountsEntryR\vstageCounts\x1a?\n" +
 	"\x11StatusCountsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xe7\x01\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1a>\n" +
+	"\x10StageCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xd9\x05\n" +
 	"\n" +
 	"Vocabulary\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
@@ -942,23 +8224,249 @@ const file_proto_vocabulary_proto_rawDesc = "" +
 	"\x04word\x18\x03 \x01(\tR\x04word\x12\x18\n" +
 	"\ameaning\x18\x04 \x01(\tR\ameaning\x12\x18\n" +
 	"\aexample\x18\x05 \x01(\tR\aexample\x12\x12\n" +
-	"\x04date\x18\x06 \x01(\tR\x04date\x12\x16\n" +
-	"\x06status\x18\a \x01(\tR\x06status\x12\x1d\n" +
+	"\x04date\x18\x06 \x01(\tR\x04date\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\tR\tupdatedAt\"6\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAt\x12\x1f\n" +
+	"\vease_factor\x18\n" +
+	" \x01(\x01R\n" +
+	"easeFactor\x12#\n" +
+	"\rinterval_days\x18\v \x01(\x05R\fintervalDays\x12 \n" +
+	"\vrepetitions\x18\f \x01(\x05R\vrepetitions\x12$\n" +
+	"\x0enext_review_at\x18\r \x01(\tR\fnextReviewAt\x12(\n" +
+	"\x10last_reviewed_at\x18\x0e \x01(\tR\x0elastReviewedAt\x12*\n" +
+	"\x11first_reviewed_at\x18\x0f \x01(\tR\x0ffirstReviewedAt\x12\x12\n" +
+	"\x04tags\x18\x10 \x03(\tR\x04tags\x12F\n" +
+	"\n" +
+	"highlights\x18\x11 \x03(\v2&.vocabulary.Vocabulary.HighlightsEntryR\n" +
+	"highlights\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x12 \x01(\tR\tdeletedAt\x12\x18\n" +
+	"\aversion\x18\x13 \x01(\x05R\aversion\x124\n" +
+	"\x06status\x18\x14 \x01(\x0e2\x1c.vocabulary.VocabularyStatusR\x06status\x12\x14\n" +
+	"\x05stage\x18\x15 \x01(\tR\x05stage\x1a=\n" +
+	"\x0fHighlightsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\a\x10\b\"k\n" +
+	"\vStatusStage\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x124\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1c.vocabulary.VocabularyStatusR\x06status\x12\x12\n" +
+	"\x04next\x18\x03 \x03(\tR\x04next\"\xb2\x02\n" +
+	"\x10StatusTransition\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12#\n" +
+	"\rvocabulary_id\x18\x02 \x01(\rR\fvocabularyId\x12\x1d\n" +
+	"\n" +
+	"from_stage\x18\x03 \x01(\tR\tfromStage\x12\x19\n" +
+	"\bto_stage\x18\x04 \x01(\tR\atoStage\x12=\n" +
+	"\vfrom_status\x18\x05 \x01(\x0e2\x1c.vocabulary.VocabularyStatusR\n" +
+	"fromStatus\x129\n" +
+	"\tto_status\x18\x06 \x01(\x0e2\x1c.vocabulary.VocabularyStatusR\btoStatus\x12\x16\n" +
+	"\x06source\x18\a \x01(\tR\x06source\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\"T\n" +
+	"\x03Tag\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12)\n" +
+	"\x10vocabulary_count\x18\x03 \x01(\x05R\x0fvocabularyCount\"\xfc\x02\n" +
+	"\x04Deck\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12'\n" +
+	"\x0fsource_language\x18\x05 \x01(\tR\x0esourceLanguage\x12'\n" +
+	"\x0ftarget_language\x18\x06 \x01(\tR\x0etargetLanguage\x12)\n" +
+	"\x11new_cards_per_day\x18\a \x01(\x05R\x0enewCardsPerDay\x12/\n" +
+	"\x14review_cards_per_day\x18\b \x01(\x05R\x11reviewCardsPerDay\x12)\n" +
+	"\x10vocabulary_count\x18\t \x01(\x05R\x0fvocabularyCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\tR\tupdatedAt\"\xc4\x01\n" +
+	"\vSavedSearch\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12:\n" +
+	"\x06filter\x18\x04 \x01(\v2\".vocabulary.GetVocabulariesRequestR\x06filter\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\"U\n" +
+	"\x0eAccountProfile\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\"u\n" +
+	"\x0eDuplicateGroup\x12'\n" +
+	"\x0fnormalized_word\x18\x01 \x01(\tR\x0enormalizedWord\x12:\n" +
+	"\fvocabularies\x18\x02 \x03(\v2\x16.vocabulary.VocabularyR\fvocabularies\"\xad\x01\n" +
+	"\x0eWordSuggestion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04word\x18\x02 \x01(\tR\x04word\x12\x18\n" +
+	"\ameaning\x18\x03 \x01(\tR\ameaning\x12!\n" +
+	"\freview_count\x18\x05 \x01(\x05R\vreviewCount\x124\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1c.vocabulary.VocabularyStatusR\x06statusJ\x04\b\x04\x10\x05\"g\n" +
+	"\x11VocabularyChanges\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x19\n" +
+	"\badd_tags\x18\x02 \x03(\tR\aaddTags\x12\x1f\n" +
+	"\vremove_tags\x18\x03 \x03(\tR\n" +
+	"removeTags\"\x8a\x02\n" +
+	"\x0fBatchItemResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12#\n" +
+	"\rvocabulary_id\x18\x04 \x01(\rR\fvocabularyId\x126\n" +
+	"\n" +
+	"vocabulary\x18\x05 \x01(\v2\x16.vocabulary.VocabularyR\n" +
+	"vocabulary\x12\x1c\n" +
+	"\tduplicate\x18\x06 \x01(\bR\tduplicate\x12\x16\n" +
+	"\x06merged\x18\a \x01(\bR\x06merged\x12\x1a\n" +
+	"\bconflict\x18\b \x01(\bR\bconflict\"\x8e\x01\n" +
+	"\x0fImportRowResult\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x12\n" +
+	"\x04word\x18\x02 \x01(\tR\x04word\x12\x16\n" +
+	"\x06result\x18\x03 \x01(\tR\x06result\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12#\n" +
+	"\rvocabulary_id\x18\x05 \x01(\rR\fvocabularyId\"6\n" +
 	"\n" +
 	"DailyCount\x12\x12\n" +
 	"\x04date\x18\x01 \x01(\tR\x04date\x12\x14\n" +
-	"\x05count\x18\x02 \x01(\x05R\x05count2\xbd\x04\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\xcc\x01\n" +
+	"\rProgressPoint\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12P\n" +
+	"\rstatus_counts\x18\x02 \x03(\v2+.vocabulary.ProgressPoint.StatusCountsEntryR\fstatusCounts\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x1a?\n" +
+	"\x11StatusCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xa5\x01\n" +
+	"\rReviewAttempt\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12#\n" +
+	"\rvocabulary_id\x18\x02 \x01(\rR\fvocabularyId\x12\x14\n" +
+	"\x05grade\x18\x03 \x01(\tR\x05grade\x12(\n" +
+	"\x10response_time_ms\x18\x04 \x01(\x05R\x0eresponseTimeMs\x12\x1f\n" +
+	"\vreviewed_at\x18\x05 \x01(\tR\n" +
+	"reviewedAt\"\xcc\x01\n" +
+	"\x12VocabularyRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12#\n" +
+	"\rvocabulary_id\x18\x02 \x01(\rR\fvocabularyId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\rR\x06userId\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x121\n" +
+	"\achanges\x18\x05 \x03(\v2\x17.vocabulary.FieldChangeR\achanges\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\"]\n" +
+	"\vFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1b\n" +
+	"\told_value\x18\x02 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x03 \x01(\tR\bnewValue\"\xa0\x01\n" +
+	"\bHardWord\x12#\n" +
+	"\rvocabulary_id\x18\x01 \x01(\rR\fvocabularyId\x12\x12\n" +
+	"\x04word\x18\x02 \x01(\tR\x04word\x12\x1a\n" +
+	"\battempts\x18\x03 \x01(\x05R\battempts\x12\x1a\n" +
+	"\bfailures\x18\x04 \x01(\x05R\bfailures\x12#\n" +
+	"\raccuracy_rate\x18\x05 \x01(\x01R\faccuracyRate\"e\n" +
+	"\fQuizQuestion\x12#\n" +
+	"\rvocabulary_id\x18\x01 \x01(\rR\fvocabularyId\x12\x16\n" +
+	"\x06prompt\x18\x02 \x01(\tR\x06prompt\x12\x18\n" +
+	"\aoptions\x18\x03 \x03(\tR\aoptions\"s\n" +
+	"\n" +
+	"QuizAnswer\x12#\n" +
+	"\rvocabulary_id\x18\x01 \x01(\rR\fvocabularyId\x12\x16\n" +
+	"\x06answer\x18\x02 \x01(\tR\x06answer\x12(\n" +
+	"\x10response_time_ms\x18\x03 \x01(\x05R\x0eresponseTimeMs\"\xc2\x01\n" +
+	"\n" +
+	"QuizResult\x12#\n" +
+	"\rvocabulary_id\x18\x01 \x01(\rR\fvocabularyId\x12\x18\n" +
+	"\acorrect\x18\x02 \x01(\bR\acorrect\x12\x16\n" +
+	"\x06answer\x18\x03 \x01(\tR\x06answer\x12%\n" +
+	"\x0ecorrect_answer\x18\x04 \x01(\tR\rcorrectAnswer\x126\n" +
+	"\n" +
+	"vocabulary\x18\x05 \x01(\v2\x16.vocabulary.VocabularyR\n" +
+	"vocabulary\"\x85\x01\n" +
+	"\tClozeItem\x12#\n" +
+	"\rvocabulary_id\x18\x01 \x01(\rR\fvocabularyId\x12\x1a\n" +
+	"\bsentence\x18\x02 \x01(\tR\bsentence\x12\x12\n" +
+	"\x04hint\x18\x03 \x01(\tR\x04hint\x12#\n" +
+	"\ranswer_length\x18\x04 \x01(\x05R\fanswerLength\"]\n" +
+	"\n" +
+	"ClozeIssue\x12#\n" +
+	"\rvocabulary_id\x18\x01 \x01(\rR\fvocabularyId\x12\x12\n" +
+	"\x04word\x18\x02 \x01(\tR\x04word\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"J\n" +
+	"\vClozeAnswer\x12#\n" +
+	"\rvocabulary_id\x18\x01 \x01(\rR\fvocabularyId\x12\x16\n" +
+	"\x06answer\x18\x02 \x01(\tR\x06answer\"\xb2\x01\n" +
+	"\vClozeResult\x12#\n" +
+	"\rvocabulary_id\x18\x01 \x01(\rR\fvocabularyId\x12\x18\n" +
+	"\acorrect\x18\x02 \x01(\bR\acorrect\x12\x14\n" +
+	"\x05exact\x18\x03 \x01(\bR\x05exact\x12\x16\n" +
+	"\x06answer\x18\x04 \x01(\tR\x06answer\x12\x1a\n" +
+	"\bexpected\x18\x05 \x01(\tR\bexpected\x12\x1a\n" +
+	"\bdistance\x18\x06 \x01(\x05R\bdistance*\x99\x01\n" +
+	"\x10VocabularyStatus\x12!\n" +
+	"\x1dVOCABULARY_STATUS_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fVOCABULARY_STATUS_REVIEW_NEEDED\x10\x01\x12\x1d\n" +
+	"\x19VOCABULARY_STATUS_LEARNED\x10\x02\x12\x1e\n" +
+	"\x1aVOCABULARY_STATUS_MASTERED\x10\x032\xeb$\n" +
 	"\x11VocabularyService\x12Z\n" +
 	"\x0fGetVocabularies\x12\".vocabulary.GetVocabulariesRequest\x1a#.vocabulary.GetVocabulariesResponse\x12W\n" +
 	"\x10CreateVocabulary\x12#.vocabulary.CreateVocabularyRequest\x1a\x1e.vocabulary.VocabularyResponse\x12W\n" +
 	"\x10UpdateVocabulary\x12#.vocabulary.UpdateVocabularyRequest\x1a\x1e.vocabulary.VocabularyResponse\x12]\n" +
 	"\x10DeleteVocabulary\x12#.vocabulary.DeleteVocabularyRequest\x1a$.vocabulary.DeleteVocabularyResponse\x12Y\n" +
 	"\x11GetVocabularyById\x12$.vocabulary.GetVocabularyByIdRequest\x1a\x1e.vocabulary.VocabularyResponse\x12`\n" +
-	"\x12GetVocabularyStats\x12%.vocabulary.GetVocabularyStatsRequest\x1a#.vocabulary.VocabularyStatsResponseB3Z1github.com/vocal-tracker/vocabulary-service/protob\x06proto3"
+	"\x12GetVocabularyStats\x12%.vocabulary.GetVocabularyStatsRequest\x1a#.vocabulary.VocabularyStatsResponse\x12W\n" +
+	"\x10ReviewVocabulary\x12#.vocabulary.ReviewVocabularyRequest\x1a\x1e.vocabulary.VocabularyResponse\x12c\n" +
+	"\x12GetDueVocabularies\x12%.vocabulary.GetDueVocabulariesRequest\x1a&.vocabulary.GetDueVocabulariesResponse\x12]\n" +
+	"\x10GetReviewHistory\x12#.vocabulary.GetReviewHistoryRequest\x1a$.vocabulary.GetReviewHistoryResponse\x12Q\n" +
+	"\fGenerateQuiz\x12\x1f.vocabulary.GenerateQuizRequest\x1a .vocabulary.GenerateQuizResponse\x12K\n" +
+	"\n" +
+	"SubmitQuiz\x12\x1d.vocabulary.SubmitQuizRequest\x1a\x1e.vocabulary.SubmitQuizResponse\x12]\n" +
+	"\x16GenerateClozeExercises\x12 .vocabulary.GenerateClozeRequest\x1a!.vocabulary.GenerateClozeResponse\x12K\n" +
+	"\n" +
+	"GradeCloze\x12\x1d.vocabulary.GradeClozeRequest\x1a\x1e.vocabulary.GradeClozeResponse\x12N\n" +
+	"\fSetDailyGoal\x12\x1f.vocabulary.SetDailyGoalRequest\x1a\x1d.vocabulary.DailyGoalResponse\x12E\n" +
+	"\bListTags\x12\x1b.vocabulary.ListTagsRequest\x1a\x1c.vocabulary.ListTagsResponse\x12B\n" +
+	"\tRenameTag\x12\x1c.vocabulary.RenameTagRequest\x1a\x17.vocabulary.TagResponse\x12H\n" +
+	"\tDeleteTag\x12\x1c.vocabulary.DeleteTagRequest\x1a\x1d.vocabulary.DeleteTagResponse\x12E\n" +
+	"\n" +
+	"CreateDeck\x12\x1d.vocabulary.CreateDeckRequest\x1a\x18.vocabulary.DeckResponse\x12E\n" +
+	"\bGetDecks\x12\x1b.vocabulary.GetDecksRequest\x1a\x1c.vocabulary.GetDecksResponse\x12@\n" +
+	"\vGetDeckById\x12\x17.vocabulary.DeckRequest\x1a\x18.vocabulary.DeckResponse\x12E\n" +
+	"\n" +
+	"UpdateDeck\x12\x1d.vocabulary.UpdateDeckRequest\x1a\x18.vocabulary.DeckResponse\x12E\n" +
+	"\n" +
+	"DeleteDeck\x12\x17.vocabulary.DeckRequest\x1a\x1e.vocabulary.DeleteDeckResponse\x12b\n" +
+	"\x15AddVocabulariesToDeck\x12#.vocabulary.DeckVocabulariesRequest\x1a$.vocabulary.DeckVocabulariesResponse\x12g\n" +
+	"\x1aRemoveVocabulariesFromDeck\x12#.vocabulary.DeckVocabulariesRequest\x1a$.vocabulary.DeckVocabulariesResponse\x12a\n" +
+	"\x10MoveVocabularies\x12'.vocabulary.TransferVocabulariesRequest\x1a$.vocabulary.DeckVocabulariesResponse\x12a\n" +
+	"\x10CopyVocabularies\x12'.vocabulary.TransferVocabulariesRequest\x1a$.vocabulary.DeckVocabulariesResponse\x12F\n" +
+	"\fGetDeckStats\x12\x17.vocabulary.DeckRequest\x1a\x1d.vocabulary.DeckStatsResponse\x12W\n" +
+	"\x0eFindDuplicates\x12!.vocabulary.FindDuplicatesRequest\x1a\".vocabulary.FindDuplicatesResponse\x12Y\n" +
+	"\x11MergeVocabularies\x12$.vocabulary.MergeVocabulariesRequest\x1a\x1e.vocabulary.VocabularyResponse\x12e\n" +
+	"\x12ImportVocabularies\x12%.vocabulary.ImportVocabulariesRequest\x1a&.vocabulary.ImportVocabulariesResponse(\x01\x12e\n" +
+	"\x12ExportVocabularies\x12%.vocabulary.ExportVocabulariesRequest\x1a&.vocabulary.ExportVocabulariesResponse0\x01\x12T\n" +
+	"\rBackupAccount\x12 .vocabulary.BackupAccountRequest\x1a!.vocabulary.BackupAccountResponse\x12W\n" +
+	"\x0eRestoreAccount\x12!.vocabulary.RestoreAccountRequest\x1a\".vocabulary.RestoreAccountResponse\x12Q\n" +
+	"\fSuggestWords\x12\x1f.vocabulary.SuggestWordsRequest\x1a .vocabulary.SuggestWordsResponse\x12Z\n" +
+	"\x11CreateSavedSearch\x12$.vocabulary.CreateSavedSearchRequest\x1a\x1f.vocabulary.SavedSearchResponse\x12]\n" +
+	"\x10GetSavedSearches\x12#.vocabulary.GetSavedSearchesRequest\x1a$.vocabulary.GetSavedSearchesResponse\x12Z\n" +
+	"\x11UpdateSavedSearch\x12$.vocabulary.UpdateSavedSearchRequest\x1a\x1f.vocabulary.SavedSearchResponse\x12Z\n" +
+	"\x11DeleteSavedSearch\x12\x1e.vocabulary.SavedSearchRequest\x1a%.vocabulary.DeleteSavedSearchResponse\x12f\n" +
+	"\x15GetSavedSearchResults\x12(.vocabulary.GetSavedSearchResultsRequest\x1a#.vocabulary.GetVocabulariesResponse\x12l\n" +
+	"\x17BatchCreateVocabularies\x12*.vocabulary.BatchCreateVocabulariesRequest\x1a%.vocabulary.BatchVocabulariesResponse\x12l\n" +
+	"\x17BatchUpdateVocabularies\x12*.vocabulary.BatchUpdateVocabulariesRequest\x1a%.vocabulary.BatchVocabulariesResponse\x12l\n" +
+	"\x17BatchDeleteVocabularies\x12*.vocabulary.BatchDeleteVocabulariesRequest\x1a%.vocabulary.BatchVocabulariesResponse\x12{\n" +
+	"\x1aUpdateVocabulariesByFilter\x12-.vocabulary.UpdateVocabulariesByFilterRequest\x1a..vocabulary.UpdateVocabulariesByFilterResponse\x12H\n" +
+	"\tListTrash\x12\x1c.vocabulary.ListTrashRequest\x1a\x1d.vocabulary.ListTrashResponse\x12Y\n" +
+	"\x11RestoreVocabulary\x12$.vocabulary.RestoreVocabularyRequest\x1a\x1e.vocabulary.VocabularyResponse\x12K\n" +
+	"\n" +
+	"PurgeTrash\x12\x1d.vocabulary.PurgeTrashRequest\x1a\x1e.vocabulary.PurgeTrashResponse\x12o\n" +
+	"\x16GetVocabularyRevisions\x12).vocabulary.GetVocabularyRevisionsRequest\x1a*.vocabulary.GetVocabularyRevisionsResponse\x12W\n" +
+	"\x10RevertVocabulary\x12#.vocabulary.RevertVocabularyRequest\x1a\x1e.vocabulary.VocabularyResponse\x12W\n" +
+	"\x0fGetStatusStages\x12\".vocabulary.GetStatusStagesRequest\x1a .vocabulary.StatusStagesResponse\x12W\n" +
+	"\x0fSetStatusStages\x12\".vocabulary.SetStatusStagesRequest\x1a .vocabulary.StatusStagesResponse\x12i\n" +
+	"\x14GetStatusTransitions\x12'.vocabulary.GetStatusTransitionsRequest\x1a(.vocabulary.GetStatusTransitionsResponse\x12f\n" +
+	"\x13GetProgressTimeline\x12&.vocabulary.GetProgressTimelineRequest\x1a'.vocabulary.GetProgressTimelineResponseB3Z1github.com/vocal-tracker/vocabulary-service/protob\x06proto3"
 
 var (
 	file_proto_vocabulary_proto_rawDescOnce sync.Once
@@ -972,44 +8480,292 @@ func file_proto_vocabulary_proto_rawDescGZIP() []byte {
 	return file_proto_vocabulary_proto_rawDescData
 }
 
-var file_proto_vocabulary_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_proto_vocabulary_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_vocabulary_proto_msgTypes = make([]protoimpl.MessageInfo, 114)
 var file_proto_vocabulary_proto_goTypes = []any{
-	(*GetVocabulariesRequest)(nil),    // 0: vocabulary.GetVocabulariesRequest
-	(*CreateVocabularyRequest)(nil),   // 1: vocabulary.CreateVocabularyRequest
-	(*UpdateVocabularyRequest)(nil),   // 2: vocabulary.UpdateVocabularyRequest
-	(*DeleteVocabularyRequest)(nil),   // 3: vocabulary.DeleteVocabularyRequest
-	(*GetVocabularyByIdRequest)(nil),  // 4: vocabulary.GetVocabularyByIdRequest
-	(*GetVocabularyStatsRequest)(nil), // 5: vocabulary.GetVocabularyStatsRequest
-	(*GetVocabulariesResponse)(nil),   // 6: vocabulary.GetVocabulariesResponse
-	(*VocabularyResponse)(nil),        // 7: vocabulary.VocabularyResponse
-	(*DeleteVocabularyResponse)(nil),  // 8: vocabulary.DeleteVocabularyResponse
-	(*VocabularyStatsResponse)(nil),   // 9: vocabulary.VocabularyStatsResponse
-	(*Vocabulary)(nil),                // 10: vocabulary.Vocabulary
-	(*DailyCount)(nil),                // 11: vocabulary.DailyCount
-	nil,                               // 12: vocabulary.VocabularyStatsResponse.StatusCountsEntry
+	(VocabularyStatus)(0),                      // 0: vocabulary.VocabularyStatus
+	(*GetVocabulariesRequest)(nil),             // 1: vocabulary.GetVocabulariesRequest
+	(*CreateVocabularyRequest)(nil),            // 2: vocabulary.CreateVocabularyRequest
+	(*UpdateVocabularyRequest)(nil),            // 3: vocabulary.UpdateVocabularyRequest
+	(*DeleteVocabularyRequest)(nil),            // 4: vocabulary.DeleteVocabularyRequest
+	(*GetVocabularyByIdRequest)(nil),           // 5: vocabulary.GetVocabularyByIdRequest
+	(*ReviewVocabularyRequest)(nil),            // 6: vocabulary.ReviewVocabularyRequest
+	(*GetDueVocabulariesRequest)(nil),          // 7: vocabulary.GetDueVocabulariesRequest
+	(*GetReviewHistoryRequest)(nil),            // 8: vocabulary.GetReviewHistoryRequest
+	(*GenerateQuizRequest)(nil),                // 9: vocabulary.GenerateQuizRequest
+	(*SubmitQuizRequest)(nil),                  // 10: vocabulary.SubmitQuizRequest
+	(*GenerateClozeRequest)(nil),               // 11: vocabulary.GenerateClozeRequest
+	(*GradeClozeRequest)(nil),                  // 12: vocabulary.GradeClozeRequest
+	(*SetDailyGoalRequest)(nil),                // 13: vocabulary.SetDailyGoalRequest
+	(*ListTagsRequest)(nil),                    // 14: vocabulary.ListTagsRequest
+	(*RenameTagRequest)(nil),                   // 15: vocabulary.RenameTagRequest
+	(*DeleteTagRequest)(nil),                   // 16: vocabulary.DeleteTagRequest
+	(*CreateDeckRequest)(nil),                  // 17: vocabulary.CreateDeckRequest
+	(*GetDecksRequest)(nil),                    // 18: vocabulary.GetDecksRequest
+	(*DeckRequest)(nil),                        // 19: vocabulary.DeckRequest
+	(*UpdateDeckRequest)(nil),                  // 20: vocabulary.UpdateDeckRequest
+	(*DeckVocabulariesRequest)(nil),            // 21: vocabulary.DeckVocabulariesRequest
+	(*TransferVocabulariesRequest)(nil),        // 22: vocabulary.TransferVocabulariesRequest
+	(*FindDuplicatesRequest)(nil),              // 23: vocabulary.FindDuplicatesRequest
+	(*MergeVocabulariesRequest)(nil),           // 24: vocabulary.MergeVocabulariesRequest
+	(*ImportVocabulariesRequest)(nil),          // 25: vocabulary.ImportVocabulariesRequest
+	(*ExportVocabulariesRequest)(nil),          // 26: vocabulary.ExportVocabulariesRequest
+	(*BackupAccountRequest)(nil),               // 27: vocabulary.BackupAccountRequest
+	(*RestoreAccountRequest)(nil),              // 28: vocabulary.RestoreAccountRequest
+	(*SuggestWordsRequest)(nil),                // 29: vocabulary.SuggestWordsRequest
+	(*CreateSavedSearchRequest)(nil),           // 30: vocabulary.CreateSavedSearchRequest
+	(*GetSavedSearchesRequest)(nil),            // 31: vocabulary.GetSavedSearchesRequest
+	(*UpdateSavedSearchRequest)(nil),           // 32: vocabulary.UpdateSavedSearchRequest
+	(*SavedSearchRequest)(nil),                 // 33: vocabulary.SavedSearchRequest
+	(*GetSavedSearchResultsRequest)(nil),       // 34: vocabulary.GetSavedSearchResultsRequest
+	(*BatchCreateVocabulariesRequest)(nil),     // 35: vocabulary.BatchCreateVocabulariesRequest
+	(*BatchUpdateVocabulariesRequest)(nil),     // 36: vocabulary.BatchUpdateVocabulariesRequest
+	(*BatchDeleteVocabulariesRequest)(nil),     // 37: vocabulary.BatchDeleteVocabulariesRequest
+	(*UpdateVocabulariesByFilterRequest)(nil),  // 38: vocabulary.UpdateVocabulariesByFilterRequest
+	(*ListTrashRequest)(nil),                   // 39: vocabulary.ListTrashRequest
+	(*RestoreVocabularyRequest)(nil),           // 40: vocabulary.RestoreVocabularyRequest
+	(*PurgeTrashRequest)(nil),                  // 41: vocabulary.PurgeTrashRequest
+	(*GetVocabularyRevisionsRequest)(nil),      // 42: vocabulary.GetVocabularyRevisionsRequest
+	(*RevertVocabularyRequest)(nil),            // 43: vocabulary.RevertVocabularyRequest
+	(*GetStatusStagesRequest)(nil),             // 44: vocabulary.GetStatusStagesRequest
+	(*SetStatusStagesRequest)(nil),             // 45: vocabulary.SetStatusStagesRequest
+	(*GetStatusTransitionsRequest)(nil),        // 46: vocabulary.GetStatusTransitionsRequest
+	(*GetProgressTimelineRequest)(nil),         // 47: vocabulary.GetProgressTimelineRequest
+	(*GetVocabularyStatsRequest)(nil),          // 48: vocabulary.GetVocabularyStatsRequest
+	(*GetVocabulariesResponse)(nil),            // 49: vocabulary.GetVocabulariesResponse
+	(*GetDueVocabulariesResponse)(nil),         // 50: vocabulary.GetDueVocabulariesResponse
+	(*GetReviewHistoryResponse)(nil),           // 51: vocabulary.GetReviewHistoryResponse
+	(*GenerateQuizResponse)(nil),               // 52: vocabulary.GenerateQuizResponse
+	(*SubmitQuizResponse)(nil),                 // 53: vocabulary.SubmitQuizResponse
+	(*GenerateClozeResponse)(nil),              // 54: vocabulary.GenerateClozeResponse
+	(*GradeClozeResponse)(nil),                 // 55: vocabulary.GradeClozeResponse
+	(*DailyGoalResponse)(nil),                  // 56: vocabulary.DailyGoalResponse
+	(*ListTagsResponse)(nil),                   // 57: vocabulary.ListTagsResponse
+	(*TagResponse)(nil),                        // 58: vocabulary.TagResponse
+	(*DeleteTagResponse)(nil),                  // 59: vocabulary.DeleteTagResponse
+	(*DeckResponse)(nil),                       // 60: vocabulary.DeckResponse
+	(*GetDecksResponse)(nil),                   // 61: vocabulary.GetDecksResponse
+	(*DeleteDeckResponse)(nil),                 // 62: vocabulary.DeleteDeckResponse
+	(*DeckVocabulariesResponse)(nil),           // 63: vocabulary.DeckVocabulariesResponse
+	(*DeckStatsResponse)(nil),                  // 64: vocabulary.DeckStatsResponse
+	(*FindDuplicatesResponse)(nil),             // 65: vocabulary.FindDuplicatesResponse
+	(*ImportVocabulariesResponse)(nil),         // 66: vocabulary.ImportVocabulariesResponse
+	(*ExportVocabulariesResponse)(nil),         // 67: vocabulary.ExportVocabulariesResponse
+	(*BackupAccountResponse)(nil),              // 68: vocabulary.BackupAccountResponse
+	(*RestoreAccountResponse)(nil),             // 69: vocabulary.RestoreAccountResponse
+	(*SuggestWordsResponse)(nil),               // 70: vocabulary.SuggestWordsResponse
+	(*SavedSearchResponse)(nil),                // 71: vocabulary.SavedSearchResponse
+	(*GetSavedSearchesResponse)(nil),           // 72: vocabulary.GetSavedSearchesResponse
+	(*DeleteSavedSearchResponse)(nil),          // 73: vocabulary.DeleteSavedSearchResponse
+	(*UpdateVocabulariesByFilterResponse)(nil), // 74: vocabulary.UpdateVocabulariesByFilterResponse
+	(*ListTrashResponse)(nil),                  // 75: vocabulary.ListTrashResponse
+	(*PurgeTrashResponse)(nil),                 // 76: vocabulary.PurgeTrashResponse
+	(*GetVocabularyRevisionsResponse)(nil),     // 77: vocabulary.GetVocabularyRevisionsResponse
+	(*StatusStagesResponse)(nil),               // 78: vocabulary.StatusStagesResponse
+	(*GetStatusTransitionsResponse)(nil),       // 79: vocabulary.GetStatusTransitionsResponse
+	(*GetProgressTimelineResponse)(nil),        // 80: vocabulary.GetProgressTimelineResponse
+	(*BatchVocabulariesResponse)(nil),          // 81: vocabulary.BatchVocabulariesResponse
+	(*VocabularyResponse)(nil),                 // 82: vocabulary.VocabularyResponse
+	(*DeleteVocabularyResponse)(nil),           // 83: vocabulary.DeleteVocabularyResponse
+	(*VocabularyStatsResponse)(nil),            // 84: vocabulary.VocabularyStatsResponse
+	(*Vocabulary)(nil),                         // 85: vocabulary.Vocabulary
+	(*StatusStage)(nil),                        // 86: vocabulary.StatusStage
+	(*StatusTransition)(nil),                   // 87: vocabulary.StatusTransition
+	(*Tag)(nil),                                // 88: vocabulary.Tag
+	(*Deck)(nil),                               // 89: vocabulary.Deck
+	(*SavedSearch)(nil),                        // 90: vocabulary.SavedSearch
+	(*AccountProfile)(nil),                     // 91: vocabulary.AccountProfile
+	(*DuplicateGroup)(nil),                     // 92: vocabulary.DuplicateGroup
+	(*WordSuggestion)(nil),                     // 93: vocabulary.WordSuggestion
+	(*VocabularyChanges)(nil),                  // 94: vocabulary.VocabularyChanges
+	(*BatchItemResult)(nil),                    // 95: vocabulary.BatchItemResult
+	(*ImportRowResult)(nil),                    // 96: vocabulary.ImportRowResult
+	(*DailyCount)(nil),                         // 97: vocabulary.DailyCount
+	(*ProgressPoint)(nil),                      // 98: vocabulary.ProgressPoint
+	(*ReviewAttempt)(nil),                      // 99: vocabulary.ReviewAttempt
+	(*VocabularyRevision)(nil),                 // 100: vocabulary.VocabularyRevision
+	(*FieldChange)(nil),                        // 101: vocabulary.FieldChange
+	(*HardWord)(nil),                           // 102: vocabulary.HardWord
+	(*QuizQuestion)(nil),                       // 103: vocabulary.QuizQuestion
+	(*QuizAnswer)(nil),                         // 104: vocabulary.QuizAnswer
+	(*QuizResult)(nil),                         // 105: vocabulary.QuizResult
+	(*ClozeItem)(nil),                          // 106: vocabulary.ClozeItem
+	(*ClozeIssue)(nil),                         // 107: vocabulary.ClozeIssue
+	(*ClozeAnswer)(nil),                        // 108: vocabulary.ClozeAnswer
+	(*ClozeResult)(nil),                        // 109: vocabulary.ClozeResult
+	nil,                                        // 110: vocabulary.DeckStatsResponse.StatusCountsEntry
+	nil,                                        // 111: vocabulary.VocabularyStatsResponse.StatusCountsEntry
+	nil,                                        // 112: vocabulary.VocabularyStatsResponse.StageCountsEntry
+	nil,                                        // 113: vocabulary.Vocabulary.HighlightsEntry
+	nil,                                        // 114: vocabulary.ProgressPoint.StatusCountsEntry
+	(*fieldmaskpb.FieldMask)(nil),              // 115: google.protobuf.FieldMask
 }
 var file_proto_vocabulary_proto_depIdxs = []int32{
-	10, // 0: vocabulary.GetVocabulariesResponse.vocabularies:type_name -> vocabulary.Vocabulary
-	10, // 1: vocabulary.VocabularyResponse.vocabulary:type_name -> vocabulary.Vocabulary
-	12, // 2: vocabulary.VocabularyStatsResponse.status_counts:type_name -> vocabulary.VocabularyStatsResponse.StatusCountsEntry
-	11, // 3: vocabulary.VocabularyStatsResponse.daily_counts:type_name -> vocabulary.DailyCount
-	0,  // 4: vocabulary.VocabularyService.GetVocabularies:input_type -> vocabulary.GetVocabulariesRequest
-	1,  // 5: vocabulary.VocabularyService.CreateVocabulary:input_type -> vocabulary.CreateVocabularyRequest
-	2,  // 6: vocabulary.VocabularyService.UpdateVocabulary:input_type -> vocabulary.UpdateVocabularyRequest
-	3,  // 7: vocabulary.VocabularyService.DeleteVocabulary:input_type -> vocabulary.DeleteVocabularyRequest
-	4,  // 8: vocabulary.VocabularyService.GetVocabularyById:input_type -> vocabulary.GetVocabularyByIdRequest
-	5,  // 9: vocabulary.VocabularyService.GetVocabularyStats:input_type -> vocabulary.GetVocabularyStatsRequest
-	6,  // 10: vocabulary.VocabularyService.GetVocabularies:output_type -> vocabulary.GetVocabulariesResponse
-	7,  // 11: vocabulary.VocabularyService.CreateVocabulary:output_type -> vocabulary.VocabularyResponse
-	7,  // 12: vocabulary.VocabularyService.UpdateVocabulary:output_type -> vocabulary.VocabularyResponse
-	8,  // 13: vocabulary.VocabularyService.DeleteVocabulary:output_type -> vocabulary.DeleteVocabularyResponse
-	7,  // 14: vocabulary.VocabularyService.GetVocabularyById:output_type -> vocabulary.VocabularyResponse
-	9,  // 15: vocabulary.VocabularyService.GetVocabularyStats:output_type -> vocabulary.VocabularyStatsResponse
-	10, // [10:16] is the sub-list for method output_type
-	4,  // [4:10] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	115, // 0: vocabulary.UpdateVocabularyRequest.update_mask:type_name -> google.protobuf.FieldMask
+	104, // 1: vocabulary.SubmitQuizRequest.answers:type_name -> vocabulary.QuizAnswer
+	108, // 2: vocabulary.GradeClozeRequest.answers:type_name -> vocabulary.ClozeAnswer
+	1,   // 3: vocabulary.ExportVocabulariesRequest.filter:type_name -> vocabulary.GetVocabulariesRequest
+	91,  // 4: vocabulary.BackupAccountRequest.profile:type_name -> vocabulary.AccountProfile
+	1,   // 5: vocabulary.CreateSavedSearchRequest.filter:type_name -> vocabulary.GetVocabulariesRequest
+	1,   // 6: vocabulary.UpdateSavedSearchRequest.filter:type_name -> vocabulary.GetVocabulariesRequest
+	2,   // 7: vocabulary.BatchCreateVocabulariesRequest.items:type_name -> vocabulary.CreateVocabularyRequest
+	3,   // 8: vocabulary.BatchUpdateVocabulariesRequest.items:type_name -> vocabulary.UpdateVocabularyRequest
+	1,   // 9: vocabulary.UpdateVocabulariesByFilterRequest.filter:type_name -> vocabulary.GetVocabulariesRequest
+	94,  // 10: vocabulary.UpdateVocabulariesByFilterRequest.changes:type_name -> vocabulary.VocabularyChanges
+	86,  // 11: vocabulary.SetStatusStagesRequest.stages:type_name -> vocabulary.StatusStage
+	85,  // 12: vocabulary.GetVocabulariesResponse.vocabularies:type_name -> vocabulary.Vocabulary
+	85,  // 13: vocabulary.GetDueVocabulariesResponse.vocabularies:type_name -> vocabulary.Vocabulary
+	99,  // 14: vocabulary.GetReviewHistoryResponse.attempts:type_name -> vocabulary.ReviewAttempt
+	103, // 15: vocabulary.GenerateQuizResponse.questions:type_name -> vocabulary.QuizQuestion
+	105, // 16: vocabulary.SubmitQuizResponse.results:type_name -> vocabulary.QuizResult
+	106, // 17: vocabulary.GenerateClozeResponse.items:type_name -> vocabulary.ClozeItem
+	107, // 18: vocabulary.GenerateClozeResponse.unusable:type_name -> vocabulary.ClozeIssue
+	109, // 19: vocabulary.GradeClozeResponse.results:type_name -> vocabulary.ClozeResult
+	88,  // 20: vocabulary.ListTagsResponse.tags:type_name -> vocabulary.Tag
+	88,  // 21: vocabulary.TagResponse.tag:type_name -> vocabulary.Tag
+	89,  // 22: vocabulary.DeckResponse.deck:type_name -> vocabulary.Deck
+	89,  // 23: vocabulary.GetDecksResponse.decks:type_name -> vocabulary.Deck
+	89,  // 24: vocabulary.DeckStatsResponse.deck:type_name -> vocabulary.Deck
+	110, // 25: vocabulary.DeckStatsResponse.status_counts:type_name -> vocabulary.DeckStatsResponse.StatusCountsEntry
+	92,  // 26: vocabulary.FindDuplicatesResponse.groups:type_name -> vocabulary.DuplicateGroup
+	96,  // 27: vocabulary.ImportVocabulariesResponse.rows:type_name -> vocabulary.ImportRowResult
+	91,  // 28: vocabulary.RestoreAccountResponse.profile:type_name -> vocabulary.AccountProfile
+	93,  // 29: vocabulary.SuggestWordsResponse.suggestions:type_name -> vocabulary.WordSuggestion
+	90,  // 30: vocabulary.SavedSearchResponse.saved_search:type_name -> vocabulary.SavedSearch
+	90,  // 31: vocabulary.GetSavedSearchesResponse.saved_searches:type_name -> vocabulary.SavedSearch
+	85,  // 32: vocabulary.UpdateVocabulariesByFilterResponse.sample:type_name -> vocabulary.Vocabulary
+	85,  // 33: vocabulary.ListTrashResponse.vocabularies:type_name -> vocabulary.Vocabulary
+	100, // 34: vocabulary.GetVocabularyRevisionsResponse.revisions:type_name -> vocabulary.VocabularyRevision
+	86,  // 35: vocabulary.StatusStagesResponse.stages:type_name -> vocabulary.StatusStage
+	87,  // 36: vocabulary.GetStatusTransitionsResponse.transitions:type_name -> vocabulary.StatusTransition
+	98,  // 37: vocabulary.GetProgressTimelineResponse.points:type_name -> vocabulary.ProgressPoint
+	95,  // 38: vocabulary.BatchVocabulariesResponse.results:type_name -> vocabulary.BatchItemResult
+	85,  // 39: vocabulary.VocabularyResponse.vocabulary:type_name -> vocabulary.Vocabulary
+	111, // 40: vocabulary.VocabularyStatsResponse.status_counts:type_name -> vocabulary.VocabularyStatsResponse.StatusCountsEntry
+	97,  // 41: vocabulary.VocabularyStatsResponse.daily_counts:type_name -> vocabulary.DailyCount
+	102, // 42: vocabulary.VocabularyStatsResponse.hardest_words:type_name -> vocabulary.HardWord
+	112, // 43: vocabulary.VocabularyStatsResponse.stage_counts:type_name -> vocabulary.VocabularyStatsResponse.StageCountsEntry
+	113, // 44: vocabulary.Vocabulary.highlights:type_name -> vocabulary.Vocabulary.HighlightsEntry
+	0,   // 45: vocabulary.Vocabulary.status:type_name -> vocabulary.VocabularyStatus
+	0,   // 46: vocabulary.StatusStage.status:type_name -> vocabulary.VocabularyStatus
+	0,   // 47: vocabulary.StatusTransition.from_status:type_name -> vocabulary.VocabularyStatus
+	0,   // 48: vocabulary.StatusTransition.to_status:type_name -> vocabulary.VocabularyStatus
+	1,   // 49: vocabulary.SavedSearch.filter:type_name -> vocabulary.GetVocabulariesRequest
+	85,  // 50: vocabulary.DuplicateGroup.vocabularies:type_name -> vocabulary.Vocabulary
+	0,   // 51: vocabulary.WordSuggestion.status:type_name -> vocabulary.VocabularyStatus
+	85,  // 52: vocabulary.BatchItemResult.vocabulary:type_name -> vocabulary.Vocabulary
+	114, // 53: vocabulary.ProgressPoint.status_counts:type_name -> vocabulary.ProgressPoint.StatusCountsEntry
+	101, // 54: vocabulary.VocabularyRevision.changes:type_name -> vocabulary.FieldChange
+	85,  // 55: vocabulary.QuizResult.vocabulary:type_name -> vocabulary.Vocabulary
+	1,   // 56: vocabulary.VocabularyService.GetVocabularies:input_type -> vocabulary.GetVocabulariesRequest
+	2,   // 57: vocabulary.VocabularyService.CreateVocabulary:input_type -> vocabulary.CreateVocabularyRequest
+	3,   // 58: vocabulary.VocabularyService.UpdateVocabulary:input_type -> vocabulary.UpdateVocabularyRequest
+	4,   // 59: vocabulary.VocabularyService.DeleteVocabulary:input_type -> vocabulary.DeleteVocabularyRequest
+	5,   // 60: vocabulary.VocabularyService.GetVocabularyById:input_type -> vocabulary.GetVocabularyByIdRequest
+	48,  // 61: vocabulary.VocabularyService.GetVocabularyStats:input_type -> vocabulary.GetVocabularyStatsRequest
+	6,   // 62: vocabulary.VocabularyService.ReviewVocabulary:input_type -> vocabulary.ReviewVocabularyRequest
+	7,   // 63: vocabulary.VocabularyService.GetDueVocabularies:input_type -> vocabulary.GetDueVocabulariesRequest
+	8,   // 64: vocabulary.VocabularyService.GetReviewHistory:input_type -> vocabulary.GetReviewHistoryRequest
+	9,   // 65: vocabulary.VocabularyService.GenerateQuiz:input_type -> vocabulary.GenerateQuizRequest
+	10,  // 66: vocabulary.VocabularyService.SubmitQuiz:input_type -> vocabulary.SubmitQuizRequest
+	11,  // 67: vocabulary.VocabularyService.GenerateClozeExercises:input_type -> vocabulary.GenerateClozeRequest
+	12,  // 68: vocabulary.VocabularyService.GradeCloze:input_type -> vocabulary.GradeClozeRequest
+	13,  // 69: vocabulary.VocabularyService.SetDailyGoal:input_type -> vocabulary.SetDailyGoalRequest
+	14,  // 70: vocabulary.VocabularyService.ListTags:input_type -> vocabulary.ListTagsRequest
+	15,  // 71: vocabulary.VocabularyService.RenameTag:input_type -> vocabulary.RenameTagRequest
+	16,  // 72: vocabulary.VocabularyService.DeleteTag:input_type -> vocabulary.DeleteTagRequest
+	17,  // 73: vocabulary.VocabularyService.CreateDeck:input_type -> vocabulary.CreateDeckRequest
+	18,  // 74: vocabulary.VocabularyService.GetDecks:input_type -> vocabulary.GetDecksRequest
+	19,  // 75: vocabulary.VocabularyService.GetDeckById:input_type -> vocabulary.DeckRequest
+	20,  // 76: vocabulary.VocabularyService.UpdateDeck:input_type -> vocabulary.UpdateDeckRequest
+	19,  // 77: vocabulary.VocabularyService.DeleteDeck:input_type -> vocabulary.DeckRequest
+	21,  // 78: vocabulary.VocabularyService.AddVocabulariesToDeck:input_type -> vocabulary.DeckVocabulariesRequest
+	21,  // 79: vocabulary.VocabularyService.RemoveVocabulariesFromDeck:input_type -> vocabulary.DeckVocabulariesRequest
+	22,  // 80: vocabulary.VocabularyService.MoveVocabularies:input_type -> vocabulary.TransferVocabulariesRequest
+	22,  // 81: vocabulary.VocabularyService.CopyVocabularies:input_type -> vocabulary.TransferVocabulariesRequest
+	19,  // 82: vocabulary.VocabularyService.GetDeckStats:input_type -> vocabulary.DeckRequest
+	23,  // 83: vocabulary.VocabularyService.FindDuplicates:input_type -> vocabulary.FindDuplicatesRequest
+	24,  // 84: vocabulary.VocabularyService.MergeVocabularies:input_type -> vocabulary.MergeVocabulariesRequest
+	25,  // 85: vocabulary.VocabularyService.ImportVocabularies:input_type -> vocabulary.ImportVocabulariesRequest
+	26,  // 86: vocabulary.VocabularyService.ExportVocabularies:input_type -> vocabulary.ExportVocabulariesRequest
+	27,  // 87: vocabulary.VocabularyService.BackupAccount:input_type -> vocabulary.BackupAccountRequest
+	28,  // 88: vocabulary.VocabularyService.RestoreAccount:input_type -> vocabulary.RestoreAccountRequest
+	29,  // 89: vocabulary.VocabularyService.SuggestWords:input_type -> vocabulary.SuggestWordsRequest
+	30,  // 90: vocabulary.VocabularyService.CreateSavedSearch:input_type -> vocabulary.CreateSavedSearchRequest
+	31,  // 91: vocabulary.VocabularyService.GetSavedSearches:input_type -> vocabulary.GetSavedSearchesRequest
+	32,  // 92: vocabulary.VocabularyService.UpdateSavedSearch:input_type -> vocabulary.UpdateSavedSearchRequest
+	33,  // 93: vocabulary.VocabularyService.DeleteSavedSearch:input_type -> vocabulary.SavedSearchRequest
+	34,  // 94: vocabulary.VocabularyService.GetSavedSearchResults:input_type -> vocabulary.GetSavedSearchResultsRequest
+	35,  // 95: vocabulary.VocabularyService.BatchCreateVocabularies:input_type -> vocabulary.BatchCreateVocabulariesRequest
+	36,  // 96: vocabulary.VocabularyService.BatchUpdateVocabularies:input_type -> vocabulary.BatchUpdateVocabulariesRequest
+	37,  // 97: vocabulary.VocabularyService.BatchDeleteVocabularies:input_type -> vocabulary.BatchDeleteVocabulariesRequest
+	38,  // 98: vocabulary.VocabularyService.UpdateVocabulariesByFilter:input_type -> vocabulary.UpdateVocabulariesByFilterRequest
+	39,  // 99: vocabulary.VocabularyService.ListTrash:input_type -> vocabulary.ListTrashRequest
+	40,  // 100: vocabulary.VocabularyService.RestoreVocabulary:input_type -> vocabulary.RestoreVocabularyRequest
+	41,  // 101: vocabulary.VocabularyService.PurgeTrash:input_type -> vocabulary.PurgeTrashRequest
+	42,  // 102: vocabulary.VocabularyService.GetVocabularyRevisions:input_type -> vocabulary.GetVocabularyRevisionsRequest
+	43,  // 103: vocabulary.VocabularyService.RevertVocabulary:input_type -> vocabulary.RevertVocabularyRequest
+	44,  // 104: vocabulary.VocabularyService.GetStatusStages:input_type -> vocabulary.GetStatusStagesRequest
+	45,  // 105: vocabulary.VocabularyService.SetStatusStages:input_type -> vocabulary.SetStatusStagesRequest
+	46,  // 106: vocabulary.VocabularyService.GetStatusTransitions:input_type -> vocabulary.GetStatusTransitionsRequest
+	47,  // 107: vocabulary.VocabularyService.GetProgressTimeline:input_type -> vocabulary.GetProgressTimelineRequest
+	49,  // 108: vocabulary.VocabularyService.GetVocabularies:output_type -> vocabulary.GetVocabulariesResponse
+	82,  // 109: vocabulary.VocabularyService.CreateVocabulary:output_type -> vocabulary.VocabularyResponse
+	82,  // 110: vocabulary.VocabularyService.UpdateVocabulary:output_type -> vocabulary.VocabularyResponse
+	83,  // 111: vocabulary.VocabularyService.DeleteVocabulary:output_type -> vocabulary.DeleteVocabularyResponse
+	82,  // 112: vocabulary.VocabularyService.GetVocabularyById:output_type -> vocabulary.VocabularyResponse
+	84,  // 113: vocabulary.VocabularyService.GetVocabularyStats:output_type -> vocabulary.VocabularyStatsResponse
+	82,  // 114: vocabulary.VocabularyService.ReviewVocabulary:output_type -> vocabulary.VocabularyResponse
+	50,  // 115: vocabulary.VocabularyService.GetDueVocabularies:output_type -> vocabulary.GetDueVocabulariesResponse
+	51,  // 116: vocabulary.VocabularyService.GetReviewHistory:output_type -> vocabulary.GetReviewHistoryResponse
+	52,  // 117: vocabulary.VocabularyService.GenerateQuiz:output_type -> vocabulary.GenerateQuizResponse
+	53,  // 118: vocabulary.VocabularyService.SubmitQuiz:output_type -> vocabulary.SubmitQuizResponse
+	54,  // 119: vocabulary.VocabularyService.GenerateClozeExercises:output_type -> vocabulary.GenerateClozeResponse
+	55,  // 120: vocabulary.VocabularyService.GradeCloze:output_type -> vocabulary.GradeClozeResponse
+	56,  // 121: vocabulary.VocabularyService.SetDailyGoal:output_type -> vocabulary.DailyGoalResponse
+	57,  // 122: vocabulary.VocabularyService.ListTags:output_type -> vocabulary.ListTagsResponse
+	58,  // 123: vocabulary.VocabularyService.RenameTag:output_type -> vocabulary.TagResponse
+	59,  // 124: vocabulary.VocabularyService.DeleteTag:output_type -> vocabulary.DeleteTagResponse
+	60,  // 125: vocabulary.VocabularyService.CreateDeck:output_type -> vocabulary.DeckResponse
+	61,  // 126: vocabulary.VocabularyService.GetDecks:output_type -> vocabulary.GetDecksResponse
+	60,  // 127: vocabulary.VocabularyService.GetDeckById:output_type -> vocabulary.DeckResponse
+	60,  // 128: vocabulary.VocabularyService.UpdateDeck:output_type -> vocabulary.DeckResponse
+	62,  // 129: vocabulary.VocabularyService.DeleteDeck:output_type -> vocabulary.DeleteDeckResponse
+	63,  // 130: vocabulary.VocabularyService.AddVocabulariesToDeck:output_type -> vocabulary.DeckVocabulariesResponse
+	63,  // 131: vocabulary.VocabularyService.RemoveVocabulariesFromDeck:output_type -> vocabulary.DeckVocabulariesResponse
+	63,  // 132: vocabulary.VocabularyService.MoveVocabularies:output_type -> vocabulary.DeckVocabulariesResponse
+	63,  // 133: vocabulary.VocabularyService.CopyVocabularies:output_type -> vocabulary.DeckVocabulariesResponse
+	64,  // 134: vocabulary.VocabularyService.GetDeckStats:output_type -> vocabulary.DeckStatsResponse
+	65,  // 135: vocabulary.VocabularyService.FindDuplicates:output_type -> vocabulary.FindDuplicatesResponse
+	82,  // 136: vocabulary.VocabularyService.MergeVocabularies:output_type -> vocabulary.VocabularyResponse
+	66,  // 137: vocabulary.VocabularyService.ImportVocabularies:output_type -> vocabulary.ImportVocabulariesResponse
+	67,  // 138: vocabulary.VocabularyService.ExportVocabularies:output_type -> vocabulary.ExportVocabulariesResponse
+	68,  // 139: vocabulary.VocabularyService.BackupAccount:output_type -> vocabulary.BackupAccountResponse
+	69,  // 140: vocabulary.VocabularyService.RestoreAccount:output_type -> vocabulary.RestoreAccountResponse
+	70,  // 141: vocabulary.VocabularyService.SuggestWords:output_type -> vocabulary.SuggestWordsResponse
+	71,  // 142: vocabulary.VocabularyService.CreateSavedSearch:output_type -> vocabulary.SavedSearchResponse
+	72,  // 143: vocabulary.VocabularyService.GetSavedSearches:output_type -> vocabulary.GetSavedSearchesResponse
+	71,  // 144: vocabulary.VocabularyService.UpdateSavedSearch:output_type -> vocabulary.SavedSearchResponse
+	73,  // 145: vocabulary.VocabularyService.DeleteSavedSearch:output_type -> vocabulary.DeleteSavedSearchResponse
+	49,  // 146: vocabulary.VocabularyService.GetSavedSearchResults:output_type -> vocabulary.GetVocabulariesResponse
+	81,  // 147: vocabulary.VocabularyService.BatchCreateVocabularies:output_type -> vocabulary.BatchVocabulariesResponse
+	81,  // 148: vocabulary.VocabularyService.BatchUpdateVocabularies:output_type -> vocabulary.BatchVocabulariesResponse
+	81,  // 149: vocabulary.VocabularyService.BatchDeleteVocabularies:output_type -> vocabulary.BatchVocabulariesResponse
+	74,  // 150: vocabulary.VocabularyService.UpdateVocabulariesByFilter:output_type -> vocabulary.UpdateVocabulariesByFilterResponse
+	75,  // 151: vocabulary.VocabularyService.ListTrash:output_type -> vocabulary.ListTrashResponse
+	82,  // 152: vocabulary.VocabularyService.RestoreVocabulary:output_type -> vocabulary.VocabularyResponse
+	76,  // 153: vocabulary.VocabularyService.PurgeTrash:output_type -> vocabulary.PurgeTrashResponse
+	77,  // 154: vocabulary.VocabularyService.GetVocabularyRevisions:output_type -> vocabulary.GetVocabularyRevisionsResponse
+	82,  // 155: vocabulary.VocabularyService.RevertVocabulary:output_type -> vocabulary.VocabularyResponse
+	78,  // 156: vocabulary.VocabularyService.GetStatusStages:output_type -> vocabulary.StatusStagesResponse
+	78,  // 157: vocabulary.VocabularyService.SetStatusStages:output_type -> vocabulary.StatusStagesResponse
+	79,  // 158: vocabulary.VocabularyService.GetStatusTransitions:output_type -> vocabulary.GetStatusTransitionsResponse
+	80,  // 159: vocabulary.VocabularyService.GetProgressTimeline:output_type -> vocabulary.GetProgressTimelineResponse
+	108, // [108:160] is the sub-list for method output_type
+	56,  // [56:108] is the sub-list for method input_type
+	56,  // [56:56] is the sub-list for extension type_name
+	56,  // [56:56] is the sub-list for extension extendee
+	0,   // [0:56] is the sub-list for field type_name
 }
 
 func init() { file_proto_vocabulary_proto_init() }
@@ -1017,18 +8773,20 @@ func file_proto_vocabulary_proto_init() {
 	if File_proto_vocabulary_proto != nil {
 		return
 	}
+	file_proto_vocabulary_proto_msgTypes[19].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_vocabulary_proto_rawDesc), len(file_proto_vocabulary_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   13,
+			NumEnums:      1,
+			NumMessages:   114,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_vocabulary_proto_goTypes,
 		DependencyIndexes: file_proto_vocabulary_proto_depIdxs,
+		EnumInfos:         file_proto_vocabulary_proto_enumTypes,
 		MessageInfos:      file_proto_vocabulary_proto_msgTypes,
 	}.Build()
 	File_proto_vocabulary_proto = out.File
@@ -0,0 +1,732 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/vocal-tracker/vocabulary-service/database"
+	"github.com/vocal-tracker/vocabulary-service/middleware"
+	"github.com/vocal-tracker/vocabulary-service/models"
+	"github.com/vocal-tracker/vocabulary-service/proto"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// backupArchiveVersion is the archive format written by BackupAccount.
+// RestoreAccount reads archives up to this version.
+const backupArchiveVersion = 1
+
+// Restore modes
+const (
+	restoreMerge   = "merge"
+	restoreReplace = "replace"
+)
+
+// backupArchive is the JSON document written by BackupAccount. Entries and
+// decks are identified by word and name rather than ID, so an archive can
+// be restored into another deployment.
+type backupArchive struct {
+	Version      int                `json:"version"`
+	CreatedAt    time.Time          `json:"created_at"`
+	Profile      *backupProfile     `json:"profile,omitempty"`
+	Settings     *backupSettings    `json:"settings,omitempty"`
+	Decks        []backupDeck       `json:"decks"`
+	Vocabularies []backupVocabulary `json:"vocabularies"`
+	// SavedSearches is missing from archives written before saved searches
+	SavedSearches []backupSavedSearch `json:"saved_searches"`
+	// Stages are the custom stages, in order; none for the built-in ones
+	Stages []backupStage `json:"stages,omitempty"`
+}
+
+type backupProfile struct {
+	ID        uint32 `json:"id"`
+	Email     string `json:"email"`
+	CreatedAt string `json:"created_at"`
+}
+
+type backupSettings struct {
+	DailyGoal int `json:"daily_goal"`
+}
+
+type backupStage struct {
+	Name   string   `json:"name"`
+	Status string   `json:"status"`
+	Next   []string `json:"next"`
+}
+
+type backupDeck struct {
+	Name              string    `json:"name"`
+	Description       string    `json:"description"`
+	SourceLanguage    string    `json:"source_language"`
+	TargetLanguage    string    `json:"target_language"`
+	NewCardsPerDay    int       `json:"new_cards_per_day"`
+	ReviewCardsPerDay int       `json:"review_cards_per_day"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+type backupVocabulary struct {
+	Word            string         `json:"word"`
+	Meaning         string         `json:"meaning"`
+	Example         string         `json:"example"`
+	Date            string         `json:"date"`
+	Status          string         `json:"status"`
+	Stage           string         `json:"stage,omitempty"` // Missing from archives written before stages
+	Tags            []string       `json:"tags"`
+	Decks           []string       `json:"decks"`
+	EaseFactor      float64        `json:"ease_factor"`
+	IntervalDays    int            `json:"interval_days"`
+	Repetitions     int            `json:"repetitions"`
+	NextReviewAt    *time.Time     `json:"next_review_at,omitempty"`
+	LastReviewedAt  *time.Time     `json:"last_reviewed_at,omitempty"`
+	FirstReviewedAt *time.Time     `json:"first_reviewed_at,omitempty"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	Reviews         []backupReview `json:"reviews"`
+	// Transitions is missing from archives written before stages
+	Transitions []backupTransition `json:"transitions"`
+}
+
+type backupSavedSearch struct {
+	Name      string    `json:"name"`
+	Date      string    `json:"date"`
+	Search    string    `json:"search"`
+	Query     string    `json:"q"`
+	Status    string    `json:"status"`
+	Tags      []string  `json:"tags"`
+	TagMatch  string    `json:"tag_match"`
+	Deck      string    `json:"deck"` // Deck name, empty for none
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type backupReview struct {
+	Grade          string    `json:"grade"`
+	ResponseTimeMs int       `json:"response_time_ms"`
+	ReviewedAt     time.Time `json:"reviewed_at"`
+}
+
+type backupTransition struct {
+	FromStage  string    `json:"from_stage"`
+	ToStage    string    `json:"to_stage"`
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	Source     string    `json:"source"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// BackupAccount implements the BackupAccount RPC method
+func (s *VocabularyServiceImpl) BackupAccount(ctx context.Context, req *proto.BackupAccountRequest) (*proto.BackupAccountResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.BackupAccountResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.BackupAccountResponse{
+			Success: false,
+			Message: "Access denied: can only back up your own account",
+		}, nil
+	}
+
+	archive := backupArchive{
+		Version:       backupArchiveVersion,
+		CreatedAt:     time.Now().UTC(),
+		Decks:         []backupDeck{},
+		Vocabularies:  []backupVocabulary{},
+		SavedSearches: []backupSavedSearch{},
+	}
+	if req.Profile != nil {
+		archive.Profile = &backupProfile{
+			ID:        req.Profile.Id,
+			Email:     req.Profile.Email,
+			CreatedAt: req.Profile.CreatedAt,
+		}
+	}
+
+	var settings models.UserSettings
+	if err := database.DB.Where("user_id = ?", authenticatedUserID).First(&settings).Error; err == nil {
+		archive.Settings = &backupSettings{DailyGoal: settings.DailyGoal}
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return &proto.BackupAccountResponse{
+			Success: false,
+			Message: "Failed to fetch settings",
+		}, err
+	}
+
+	var decks []models.Deck
+	if err := database.DB.Where("user_id = ?", authenticatedUserID).Order("id").Find(&decks).Error; err != nil {
+		return &proto.BackupAccountResponse{
+			Success: false,
+			Message: "Failed to fetch decks",
+		}, err
+	}
+	deckNames := make(map[uint]string, len(decks))
+	for _, deck := range decks {
+		deckNames[deck.ID] = deck.Name
+		archive.Decks = append(archive.Decks, backupDeck{
+			Name:              deck.Name,
+			Description:       deck.Description,
+			SourceLanguage:    deck.SourceLanguage,
+			TargetLanguage:    deck.TargetLanguage,
+			NewCardsPerDay:    deck.NewCardsPerDay,
+			ReviewCardsPerDay: deck.ReviewCardsPerDay,
+			CreatedAt:         deck.CreatedAt,
+			UpdatedAt:         deck.UpdatedAt,
+		})
+	}
+
+	machine, err := loadStageMachine(database.DB, uint(authenticatedUserID))
+	if err != nil {
+		return &proto.BackupAccountResponse{
+			Success: false,
+			Message: "Failed to fetch stages",
+		}, err
+	}
+	if machine.custom {
+		for _, stage := range machine.stages {
+			archive.Stages = append(archive.Stages, backupStage{Name: stage.Name, Status: stage.Status, Next: stage.Next})
+		}
+	}
+
+	var vocabularies []models.Vocabulary
+	if err := database.DB.Preload("Tags").Preload("Decks").
+		Where("user_id = ?", authenticatedUserID).
+		Order("id").
+		Find(&vocabularies).Error; err != nil {
+		return &proto.BackupAccountResponse{
+			Success: false,
+			Message: "Failed to fetch vocabularies",
+		}, err
+	}
+
+	var attempts []models.ReviewAttempt
+	if err := database.DB.Where("user_id = ?", authenticatedUserID).Order("reviewed_at").Find(&attempts).Error; err != nil {
+		return &proto.BackupAccountResponse{
+			Success: false,
+			Message: "Failed to fetch review history",
+		}, err
+	}
+	reviews := make(map[uint][]backupReview)
+	for _, attempt := range attempts {
+		reviews[attempt.VocabularyID] = append(reviews[attempt.VocabularyID], backupReview{
+			Grade:          attempt.Grade,
+			ResponseTimeMs: attempt.ResponseTimeMs,
+			ReviewedAt:     attempt.ReviewedAt,
+		})
+	}
+
+	var transitions []models.StatusTransition
+	if err := database.DB.Where("user_id = ?", authenticatedUserID).Order("created_at, id").Find(&transitions).Error; err != nil {
+		return &proto.BackupAccountResponse{
+			Success: false,
+			Message: "Failed to fetch transitions",
+		}, err
+	}
+	entryTransitions := make(map[uint][]backupTransition)
+	for _, transition := range transitions {
+		entryTransitions[transition.VocabularyID] = append(entryTransitions[transition.VocabularyID], backupTransition{
+			FromStage:  transition.FromStage,
+			ToStage:    transition.ToStage,
+			FromStatus: transition.FromStatus,
+			ToStatus:   transition.ToStatus,
+			Source:     transition.Source,
+			CreatedAt:  transition.CreatedAt,
+		})
+	}
+
+	for _, vocab := range vocabularies {
+		deckNames := make([]string, len(vocab.Decks))
+		for i, deck := range vocab.Decks {
+			deckNames[i] = deck.Name
+		}
+		entryReviews := reviews[vocab.ID]
+		if entryReviews == nil {
+			entryReviews = []backupReview{}
+		}
+		transitions := entryTransitions[vocab.ID]
+		if transitions == nil {
+			transitions = []backupTransition{}
+		}
+		archive.Vocabularies = append(archive.Vocabularies, backupVocabulary{
+			Word:            vocab.Word,
+			Meaning:         vocab.Meaning,
+			Example:         vocab.Example,
+			Date:            vocab.Date.Format("2006-01-02"),
+			Status:          vocab.Status,
+			Stage:           vocab.Stage,
+			Tags:            tagNames(vocab.Tags),
+			Decks:           deckNames,
+			EaseFactor:      vocab.EaseFactor,
+			IntervalDays:    vocab.IntervalDays,
+			Repetitions:     vocab.Repetitions,
+			NextReviewAt:    vocab.NextReviewAt,
+			LastReviewedAt:  vocab.LastReviewedAt,
+			FirstReviewedAt: vocab.FirstReviewedAt,
+			CreatedAt:       vocab.CreatedAt,
+			UpdatedAt:       vocab.UpdatedAt,
+			Reviews:         entryReviews,
+			Transitions:     transitions,
+		})
+	}
+
+	var searches []models.SavedSearch
+	if err := database.DB.Where("user_id = ?", authenticatedUserID).Order("id").Find(&searches).Error; err != nil {
+		return &proto.BackupAccountResponse{
+			Success: false,
+			Message: "Failed to fetch saved searches",
+		}, err
+	}
+	for _, search := range searches {
+		tags := search.Tags
+		if tags == nil {
+			tags = []string{}
+		}
+		archive.SavedSearches = append(archive.SavedSearches, backupSavedSearch{
+			Name:      search.Name,
+			Date:      search.Date,
+			Search:    search.Search,
+			Query:     search.Query,
+			Status:    search.Status,
+			Tags:      tags,
+			TagMatch:  search.TagMatch,
+			Deck:      deckNames[search.DeckID],
+			CreatedAt: search.CreatedAt,
+			UpdatedAt: search.UpdatedAt,
+		})
+	}
+
+	data, err := json.MarshalIndent(archive, "", "  ")
+	if err != nil {
+		return &proto.BackupAccountResponse{
+			Success: false,
+			Message: "Failed to write archive",
+		}, err
+	}
+
+	return &proto.BackupAccountResponse{
+		Success:    true,
+		Message:    "Account backed up successfully",
+		Archive:    data,
+		TotalWords: int32(len(archive.Vocabularies)),
+	}, nil
+}
+
+// RestoreAccount implements the RestoreAccount RPC method
+func (s *VocabularyServiceImpl) RestoreAccount(ctx context.Context, req *proto.RestoreAccountRequest) (*proto.RestoreAccountResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.RestoreAccountResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.RestoreAccountResponse{
+			Success: false,
+			Message: "Access denied: can only restore your own account",
+		}, nil
+	}
+
+	mode := req.Mode
+	if mode == "" {
+		mode = restoreMerge
+	}
+	if mode != restoreMerge && mode != restoreReplace {
+		return &proto.RestoreAccountResponse{
+			Success: false,
+			Message: "Invalid mode. Use merge or replace",
+		}, nil
+	}
+
+	var archive backupArchive
+	if err := json.Unmarshal(req.Archive, &archive); err != nil {
+		return &proto.RestoreAccountResponse{
+			Success: false,
+			Message: fmt.Sprintf("Invalid archive: %v", err),
+		}, nil
+	}
+	if archive.Version < 1 || archive.Version > backupArchiveVersion {
+		return &proto.RestoreAccountResponse{
+			Success: false,
+			Message: fmt.Sprintf("Unsupported archive version %d", archive.Version),
+		}, nil
+	}
+
+	// Check the whole archive before changing anything
+	if message := validateArchive(&archive); message != "" {
+		return &proto.RestoreAccountResponse{
+			Success: false,
+			Message: message,
+		}, nil
+	}
+
+	response := &proto.RestoreAccountResponse{Success: true}
+	if archive.Profile != nil {
+		response.Profile = &proto.AccountProfile{
+			Id:        archive.Profile.ID,
+			Email:     archive.Profile.Email,
+			CreatedAt: archive.Profile.CreatedAt,
+		}
+	}
+
+	userID := uint(authenticatedUserID)
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if mode == restoreReplace {
+			deleted, err := deleteAccountData(tx, userID)
+			if err != nil {
+				return err
+			}
+			response.Deleted = int32(deleted)
+		}
+
+		// Settings are only restored if the user has none, so merging keeps
+		// the current daily goal
+		if archive.Settings != nil {
+			settings := models.UserSettings{UserID: userID, DailyGoal: archive.Settings.DailyGoal}
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&settings).Error; err != nil {
+				return err
+			}
+		}
+
+		// Stages too are only restored if the user has none of their own
+		machine, err := restoreStages(tx, userID, archive.Stages)
+		if err != nil {
+			return err
+		}
+
+		decks := make(map[string]*models.Deck)
+		for _, entry := range archive.Decks {
+			deck := models.Deck{
+				UserID:            userID,
+				Name:              entry.Name,
+				Description:       entry.Description,
+				SourceLanguage:    entry.SourceLanguage,
+				TargetLanguage:    entry.TargetLanguage,
+				NewCardsPerDay:    entry.NewCardsPerDay,
+				ReviewCardsPerDay: entry.ReviewCardsPerDay,
+				CreatedAt:         entry.CreatedAt,
+				UpdatedAt:         entry.UpdatedAt,
+			}
+			if err := restoreDeck(tx, decks, &deck); err != nil {
+				return err
+			}
+		}
+
+		for _, entry := range archive.Vocabularies {
+			created, err := restoreVocabulary(tx, userID, machine, decks, &entry)
+			if err != nil {
+				return err
+			}
+			if created {
+				response.Created++
+			} else {
+				response.Skipped++
+			}
+		}
+
+		for _, entry := range archive.SavedSearches {
+			if err := restoreSavedSearch(tx, userID, decks, &entry); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return &proto.RestoreAccountResponse{
+			Success: false,
+			Message: "Failed to restore account",
+		}, err
+	}
+
+	response.Message = fmt.Sprintf("Account restored: %d created, %d skipped, %d deleted", response.Created, response.Skipped, response.Deleted)
+	return response, nil
+}
+
+// validateArchive checks every deck and entry of an archive, returning a
+// message for the user if any is invalid
+func validateArchive(archive *backupArchive) string {
+	if archive.Settings != nil && (archive.Settings.DailyGoal < 1 || archive.Settings.DailyGoal > maxDailyGoal) {
+		return fmt.Sprintf("Daily goal must be between 1 and %d", maxDailyGoal)
+	}
+
+	for i, deck := range archive.Decks {
+		if strings.TrimSpace(deck.Name) == "" {
+			return fmt.Sprintf("Deck %d: Deck name is required", i+1)
+		}
+		if utf8.RuneCountInString(deck.Name) > maxDeckNameLength {
+			return fmt.Sprintf("Deck %d: Deck name must be at most %d characters", i+1, maxDeckNameLength)
+		}
+	}
+
+	if len(archive.Stages) > 0 {
+		stages := make([]models.StatusStage, len(archive.Stages))
+		for i, stage := range archive.Stages {
+			stages[i] = models.StatusStage{Name: stage.Name, Status: stage.Status, Next: stage.Next}
+		}
+		if message := validateStages(stages); message != "" {
+			return message
+		}
+	}
+
+	for i, entry := range archive.Vocabularies {
+		if strings.TrimSpace(entry.Word) == "" {
+			return fmt.Sprintf("Vocabulary %d: Word is required", i+1)
+		}
+		if _, err := time.Parse("2006-01-02", entry.Date); err != nil {
+			return fmt.Sprintf("Vocabulary %d (%q): Invalid date format. Use YYYY-MM-DD", i+1, entry.Word)
+		}
+		if entry.Status != "" && !isValidStatus(entry.Status) {
+			return fmt.Sprintf("Vocabulary %d (%q): %s", i+1, entry.Word, invalidStatusMessage)
+		}
+		if _, err := normalizeTagNames(entry.Tags); err != nil {
+			return fmt.Sprintf("Vocabulary %d (%q): %v", i+1, entry.Word, err)
+		}
+		for _, review := range entry.Reviews {
+			if _, ok := gradeQuality[review.Grade]; !ok {
+				return fmt.Sprintf("Vocabulary %d (%q): Invalid review grade %q", i+1, entry.Word, review.Grade)
+			}
+		}
+		for _, transition := range entry.Transitions {
+			if !isValidStatus(transition.ToStatus) || (transition.FromStatus != "" && !isValidStatus(transition.FromStatus)) {
+				return fmt.Sprintf("Vocabulary %d (%q): Invalid transition status", i+1, entry.Word)
+			}
+		}
+	}
+
+	for i, search := range archive.SavedSearches {
+		if strings.TrimSpace(search.Name) == "" {
+			return fmt.Sprintf("Saved search %d: Saved search name is required", i+1)
+		}
+		if utf8.RuneCountInString(search.Name) > maxSavedSearchNameLength {
+			return fmt.Sprintf("Saved search %d: Saved search name must be at most %d characters", i+1, maxSavedSearchNameLength)
+		}
+		filter := &proto.GetVocabulariesRequest{Date: search.Date, Q: search.Query, Status: search.Status, Tags: search.Tags, TagMatch: search.TagMatch}
+		if message, _ := validateVocabularyFilter(0, filter); message != "" {
+			return fmt.Sprintf("Saved search %d (%q): %s", i+1, search.Name, message)
+		}
+	}
+	return ""
+}
+
+// deleteAccountData removes all of the user's entries, review history, edit
+// history, transitions, tags, decks, saved searches, stages, quizzes and
+// settings, returning the number of entries deleted
+func deleteAccountData(tx *gorm.DB, userID uint) (int64, error) {
+	for _, model := range []interface{}{&models.ReviewAttempt{}, &models.VocabularyRevision{}, &models.StatusTransition{}} {
+		if err := tx.Where("user_id = ?", userID).Delete(model).Error; err != nil {
+			return 0, err
+		}
+	}
+	// Entries in the trash are deleted too
+	result := tx.Unscoped().Where("user_id = ?", userID).Delete(&models.Vocabulary{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	for _, model := range []interface{}{&models.Tag{}, &models.Deck{}, &models.SavedSearch{}, &models.StatusStage{}, &models.QuizSession{}, &models.UserSettings{}} {
+		if err := tx.Where("user_id = ?", userID).Delete(model).Error; err != nil {
+			return 0, err
+		}
+	}
+	return result.RowsAffected, nil
+}
+
+// restoreDeck adds deck to decks under its lower-cased name, reusing the
+// user's existing deck with that name if there is one
+func restoreDeck(tx *gorm.DB, decks map[string]*models.Deck, deck *models.Deck) error {
+	key := strings.ToLower(deck.Name)
+	if _, ok := decks[key]; ok {
+		return nil
+	}
+
+	err := tx.Where("user_id = ? AND LOWER(name) = LOWER(?)", deck.UserID, deck.Name).First(deck).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = tx.Create(deck).Error
+	}
+	if err != nil {
+		return err
+	}
+	decks[key] = deck
+	return nil
+}
+
+// restoreStages gives the user the archived stages, unless they already have
+// custom stages, and returns the user's stages
+func restoreStages(tx *gorm.DB, userID uint, archived []backupStage) (*stageMachine, error) {
+	machine, err := loadStageMachine(tx, userID)
+	if err != nil || machine.custom || len(archived) == 0 {
+		return machine, err
+	}
+
+	stages := make([]models.StatusStage, len(archived))
+	for i, stage := range archived {
+		stages[i] = models.StatusStage{UserID: userID, Name: stage.Name, Status: stage.Status, Next: stage.Next}
+	}
+	// The archive was checked by validateArchive
+	validateStages(stages)
+	machine = &stageMachine{stages: stages, custom: true}
+	_, err = saveStages(tx, userID, machine)
+	return machine, err
+}
+
+// restoreVocabulary adds an archived entry with its schedule, tags, decks,
+// review history and transitions, unless the user already has the word. The
+// entry keeps its stage if the user has it, and otherwise goes to the stage
+// of machine with its status. It reports whether the entry was created.
+func restoreVocabulary(tx *gorm.DB, userID uint, machine *stageMachine, decks map[string]*models.Deck, entry *backupVocabulary) (bool, error) {
+	normalized := models.NormalizeWord(entry.Word)
+	existing, err := findDuplicate(tx, userID, normalized, 0)
+	if err != nil || existing != nil {
+		return false, err
+	}
+
+	names, _ := normalizeTagNames(entry.Tags)
+	tags, err := resolveTags(tx, userID, names)
+	if err != nil {
+		return false, err
+	}
+
+	// Decks missing from the archive's deck list are created with defaults
+	var entryDecks []models.Deck
+	for _, name := range entry.Decks {
+		if strings.TrimSpace(name) == "" {
+			continue
+		}
+		deck := &models.Deck{UserID: userID, Name: name}
+		if err := restoreDeck(tx, decks, deck); err != nil {
+			return false, err
+		}
+		entryDecks = append(entryDecks, *decks[strings.ToLower(name)])
+	}
+
+	date, _ := time.Parse("2006-01-02", entry.Date)
+	status := entry.Status
+	if status == "" {
+		status = models.StatusReviewNeeded
+	}
+	stage := machine.stage(strings.ToLower(entry.Stage))
+	if stage == nil {
+		stage = machine.closest("", status)
+	}
+	easeFactor := entry.EaseFactor
+	if easeFactor == 0 {
+		easeFactor = 2.5
+	}
+
+	vocab := models.Vocabulary{
+		UserID:          userID,
+		Word:            strings.TrimSpace(entry.Word),
+		NormalizedWord:  normalized,
+		Meaning:         entry.Meaning,
+		Example:         entry.Example,
+		Date:            date,
+		Status:          stage.Status,
+		Stage:           stage.Name,
+		EaseFactor:      easeFactor,
+		IntervalDays:    entry.IntervalDays,
+		Repetitions:     entry.Repetitions,
+		NextReviewAt:    entry.NextReviewAt,
+		LastReviewedAt:  entry.LastReviewedAt,
+		FirstReviewedAt: entry.FirstReviewedAt,
+		CreatedAt:       entry.CreatedAt,
+		UpdatedAt:       entry.UpdatedAt,
+		Tags:            tags,
+		Decks:           entryDecks,
+	}
+	if err := tx.Create(&vocab).Error; err != nil {
+		return false, err
+	}
+
+	if len(entry.Reviews) > 0 {
+		attempts := make([]models.ReviewAttempt, len(entry.Reviews))
+		for i, review := range entry.Reviews {
+			attempts[i] = models.ReviewAttempt{
+				VocabularyID:   vocab.ID,
+				UserID:         userID,
+				Grade:          review.Grade,
+				ResponseTimeMs: review.ResponseTimeMs,
+				ReviewedAt:     review.ReviewedAt,
+			}
+		}
+		if err := tx.Create(&attempts).Error; err != nil {
+			return false, err
+		}
+	}
+
+	// Archives written before stages have no transitions; the entry is then
+	// recorded as created at its stage
+	transitions := []models.StatusTransition{{
+		VocabularyID: vocab.ID,
+		UserID:       userID,
+		ToStage:      vocab.Stage,
+		ToStatus:     vocab.Status,
+		Source:       models.TransitionCreate,
+		CreatedAt:    vocab.CreatedAt,
+	}}
+	if len(entry.Transitions) > 0 {
+		transitions = make([]models.StatusTransition, len(entry.Transitions))
+		for i, transition := range entry.Transitions {
+			transitions[i] = models.StatusTransition{
+				VocabularyID: vocab.ID,
+				UserID:       userID,
+				FromStage:    transition.FromStage,
+				ToStage:      transition.ToStage,
+				FromStatus:   transition.FromStatus,
+				ToStatus:     transition.ToStatus,
+				Source:       transition.Source,
+				CreatedAt:    transition.CreatedAt,
+			}
+		}
+	}
+	if err := tx.Create(&transitions).Error; err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// restoreSavedSearch adds an archived saved search, unless the user already
+// has one with that name
+func restoreSavedSearch(tx *gorm.DB, userID uint, decks map[string]*models.Deck, entry *backupSavedSearch) error {
+	var count int64
+	if err := tx.Model(&models.SavedSearch{}).
+		Where("user_id = ? AND LOWER(name) = LOWER(?)", userID, entry.Name).
+		Count(&count).Error; err != nil || count > 0 {
+		return err
+	}
+
+	names, _ := normalizeTagNames(entry.Tags)
+	search := models.SavedSearch{
+		UserID:    userID,
+		Name:      strings.TrimSpace(entry.Name),
+		Date:      entry.Date,
+		Search:    entry.Search,
+		Query:     entry.Query,
+		Status:    entry.Status,
+		Tags:      names,
+		TagMatch:  entry.TagMatch,
+		CreatedAt: entry.CreatedAt,
+		UpdatedAt: entry.UpdatedAt,
+	}
+	if strings.TrimSpace(entry.Deck) != "" {
+		deck := &models.Deck{UserID: userID, Name: entry.Deck}
+		if err := restoreDeck(tx, decks, deck); err != nil {
+			return err
+		}
+		search.DeckID = decks[strings.ToLower(entry.Deck)].ID
+	}
+	return tx.Create(&search).Error
+}
@@ -0,0 +1,612 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/vocal-tracker/vocabulary-service/database"
+	"github.com/vocal-tracker/vocabulary-service/middleware"
+	"github.com/vocal-tracker/vocabulary-service/models"
+	"github.com/vocal-tracker/vocabulary-service/proto"
+
+	"gorm.io/gorm"
+)
+
+// Batch modes
+const (
+	batchAllOrNothing = "all_or_nothing"
+	batchBestEffort   = "best_effort"
+)
+
+// maxBatchSize is the largest number of items in a batch
+const maxBatchSize = 500
+
+// batchSavePoint marks the start of each step of a best effort batch
+const batchSavePoint = "batch_step"
+
+// batchNotApplied is the message of the valid items of a rolled back batch
+const batchNotApplied = "Not applied because other items failed"
+
+// errBatchRolledBack rolls back an all or nothing batch with a failed item
+var errBatchRolledBack = errors.New("batch rolled back")
+
+// batchStep applies one or more items of a batch in the batch's transaction.
+// It sets the results of its items, and returns an error only if the
+// database fails.
+type batchStep struct {
+	items []int
+	apply func(tx *gorm.DB) error
+}
+
+// BatchCreateVocabularies implements the BatchCreateVocabularies RPC method
+func (s *VocabularyServiceImpl) BatchCreateVocabularies(ctx context.Context, req *proto.BatchCreateVocabulariesRequest) (*proto.BatchVocabulariesResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.BatchVocabulariesResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.BatchVocabulariesResponse{
+			Success: false,
+			Message: "Access denied: can only create vocabularies for your own account",
+		}, nil
+	}
+
+	mode, message := batchMode(req.Mode, len(req.Items))
+	if message != "" {
+		return &proto.BatchVocabulariesResponse{
+			Success: false,
+			Message: message,
+		}, nil
+	}
+
+	machine, err := loadStageMachine(database.DB, uint(authenticatedUserID))
+	if err != nil {
+		return &proto.BatchVocabulariesResponse{
+			Success: false,
+			Message: "Failed to fetch stages",
+		}, err
+	}
+
+	// The decks of every item are looked up at once; entries can only be
+	// added to the user's own decks
+	var deckIDs []uint32
+	for _, item := range req.Items {
+		deckIDs = append(deckIDs, item.DeckIds...)
+	}
+	decks := make(map[uint32]models.Deck)
+	if deckIDs = uniqueIDs(deckIDs); len(deckIDs) > 0 {
+		var found []models.Deck
+		if err := database.DB.Where("id IN ? AND user_id = ?", deckIDs, authenticatedUserID).Find(&found).Error; err != nil {
+			return &proto.BatchVocabulariesResponse{
+				Success: false,
+				Message: "Database error",
+			}, err
+		}
+		for _, deck := range found {
+			decks[uint32(deck.ID)] = deck
+		}
+	}
+
+	results := newBatchResults(len(req.Items))
+	steps := make([]batchStep, 0, len(req.Items))
+	for i, item := range req.Items {
+		result := results[i]
+
+		vocab, err := newVocabulary(machine, authenticatedUserID, item.Word, item.Meaning, item.Example, item.Date, item.Status)
+		if err != nil {
+			result.Message = err.Error()
+			continue
+		}
+
+		names, err := normalizeTagNames(item.Tags)
+		if err != nil {
+			result.Message = err.Error()
+			continue
+		}
+
+		onDuplicate := item.OnDuplicate
+		if onDuplicate == "" {
+			onDuplicate = duplicateReject
+		}
+		if onDuplicate != duplicateReject && onDuplicate != duplicateMerge {
+			result.Message = "Invalid on_duplicate. Use reject or merge"
+			continue
+		}
+
+		for _, id := range uniqueIDs(item.DeckIds) {
+			deck, ok := decks[id]
+			if !ok {
+				result.Message = "Deck not found"
+				break
+			}
+			vocab.Decks = append(vocab.Decks, deck)
+		}
+		if result.Message != "" {
+			continue
+		}
+
+		steps = append(steps, batchStep{items: []int{i}, apply: func(tx *gorm.DB) error {
+			existing, err := createVocabulary(tx, &vocab, names, onDuplicate)
+			if err != nil {
+				return err
+			}
+
+			switch {
+			case existing == nil:
+				result.Success = true
+				result.Message = "Vocabulary created successfully"
+				result.Vocabulary = toProtoVocabulary(&vocab)
+			case onDuplicate == duplicateMerge:
+				result.Success = true
+				result.Message = "Vocabulary merged into the existing entry"
+				result.Vocabulary = toProtoVocabulary(existing)
+				result.Merged = true
+				result.VocabularyId = uint32(existing.ID)
+			default:
+				result.Message = fmt.Sprintf("Vocabulary %q already exists", existing.Word)
+				result.Vocabulary = toProtoVocabulary(existing)
+				result.Duplicate = true
+				result.VocabularyId = uint32(existing.ID)
+			}
+			return nil
+		}})
+	}
+
+	rolledBack, err := runBatch(mode, results, steps, "Failed to create vocabulary")
+	if err != nil {
+		return &proto.BatchVocabulariesResponse{
+			Success: false,
+			Message: "Failed to create vocabularies",
+		}, err
+	}
+
+	return batchResponse(results, rolledBack), nil
+}
+
+// BatchUpdateVocabularies implements the BatchUpdateVocabularies RPC method.
+// Items that only change the meaning, example or status are grouped by
+// their changes, and each group is written with a single statement. Items
+// with an expected_version are checked and written one at a time. Whether
+// an entry can move to a new status is checked against the stage it had
+// when the batch started.
+func (s *VocabularyServiceImpl) BatchUpdateVocabularies(ctx context.Context, req *proto.BatchUpdateVocabulariesRequest) (*proto.BatchVocabulariesResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.BatchVocabulariesResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.BatchVocabulariesResponse{
+			Success: false,
+			Message: "Access denied: can only update your own vocabularies",
+		}, nil
+	}
+
+	mode, message := batchMode(req.Mode, len(req.Items))
+	if message != "" {
+		return &proto.BatchVocabulariesResponse{
+			Success: false,
+			Message: message,
+		}, nil
+	}
+
+	machine, err := loadStageMachine(database.DB, uint(authenticatedUserID))
+	if err != nil {
+		return &proto.BatchVocabulariesResponse{
+			Success: false,
+			Message: "Failed to fetch stages",
+		}, err
+	}
+
+	// Every entry of the batch is loaded at once
+	ids := make([]uint32, len(req.Items))
+	for i, item := range req.Items {
+		ids[i] = item.VocabularyId
+	}
+	var vocabs []models.Vocabulary
+	if err := database.DB.Where("id IN ? AND user_id = ?", uniqueIDs(ids), authenticatedUserID).Find(&vocabs).Error; err != nil {
+		return &proto.BatchVocabulariesResponse{
+			Success: false,
+			Message: "Database error",
+		}, err
+	}
+	found := make(map[uint32]*models.Vocabulary, len(vocabs))
+	for i := range vocabs {
+		found[uint32(vocabs[i].ID)] = &vocabs[i]
+	}
+
+	results := newBatchResults(len(req.Items))
+	var steps []batchStep
+	groups := make(map[updateGroupKey][]int)
+	groupUpdates := make(map[updateGroupKey]*vocabularyUpdate)
+	var groupOrder []updateGroupKey
+	seen := make(map[uint32]bool, len(req.Items))
+	for i, item := range req.Items {
+		result := results[i]
+		result.VocabularyId = item.VocabularyId
+
+		if seen[item.VocabularyId] {
+			result.Message = "Vocabulary appears more than once in the batch"
+			continue
+		}
+		seen[item.VocabularyId] = true
+
+		vocab, ok := found[item.VocabularyId]
+		if !ok {
+			result.Message = "Vocabulary not found"
+			continue
+		}
+
+		update, err := newVocabularyUpdate(item, machine)
+		if err != nil {
+			result.Message = err.Error()
+			continue
+		}
+		if update.fields["status"] {
+			if err := machine.checkMove(vocab.Stage, update.stage); err != nil {
+				result.Message = err.Error()
+				continue
+			}
+		}
+
+		if !update.fields["word"] && !update.fields["tags"] && item.ExpectedVersion == 0 {
+			key := updateGroupKey{
+				meaning: update.meaning,
+				example: update.example,
+				status:  update.status,
+				fields:  [3]bool{update.fields["meaning"], update.fields["example"], update.fields["status"]},
+			}
+			if _, ok := groups[key]; !ok {
+				groupOrder = append(groupOrder, key)
+				groupUpdates[key] = update
+			}
+			groups[key] = append(groups[key], i)
+			continue
+		}
+
+		expectedVersion := item.ExpectedVersion
+		steps = append(steps, batchStep{items: []int{i}, apply: func(tx *gorm.DB) error {
+			if expectedVersion != 0 {
+				if err := lockVocabulary(tx, vocab); err != nil {
+					return err
+				}
+				if vocab.Version != int(expectedVersion) {
+					if err := tx.Preload("Tags").First(vocab, vocab.ID).Error; err != nil {
+						return err
+					}
+					result.Message = versionConflictMessage(expectedVersion, vocab)
+					result.Vocabulary = toProtoVocabulary(vocab)
+					result.Conflict = true
+					return nil
+				}
+			}
+			existing, err := updateVocabulary(tx, vocab, update)
+			if err != nil {
+				return err
+			}
+			if existing != nil {
+				result.Message = fmt.Sprintf("Vocabulary %q already exists", existing.Word)
+				result.Vocabulary = toProtoVocabulary(existing)
+				result.Duplicate = true
+				return nil
+			}
+			result.Success = true
+			result.Message = "Vocabulary updated successfully"
+			return nil
+		}})
+	}
+
+	for _, key := range groupOrder {
+		items, columns := groups[key], groupUpdates[key].columns()
+		steps = append(steps, batchStep{items: items, apply: func(tx *gorm.DB) error {
+			if len(columns) > 0 {
+				groupIDs := make([]uint32, len(items))
+				for j, item := range items {
+					groupIDs[j] = results[item].VocabularyId
+				}
+				var revisions []models.VocabularyRevision
+				var transitions []models.StatusTransition
+				for _, id := range groupIDs {
+					if revision := newRevision(found[id], columns, models.RevisionUpdate); revision != nil {
+						revisions = append(revisions, *revision)
+					}
+					if transition := newTransition(found[id], columns, models.RevisionUpdate); transition != nil {
+						transitions = append(transitions, *transition)
+					}
+				}
+				if len(revisions) > 0 {
+					if err := tx.Create(&revisions).Error; err != nil {
+						return err
+					}
+				}
+				if len(transitions) > 0 {
+					if err := tx.Create(&transitions).Error; err != nil {
+						return err
+					}
+				}
+				columns["version"] = nextVersion
+				if err := tx.Model(&models.Vocabulary{}).Where("id IN ?", groupIDs).Updates(columns).Error; err != nil {
+					return err
+				}
+			}
+			for _, item := range items {
+				results[item].Success = true
+				results[item].Message = "Vocabulary updated successfully"
+			}
+			return nil
+		}})
+	}
+
+	rolledBack, err := runBatch(mode, results, steps, "Failed to update vocabulary")
+	if err != nil {
+		return &proto.BatchVocabulariesResponse{
+			Success: false,
+			Message: "Failed to update vocabularies",
+		}, err
+	}
+
+	// Reload the updated entries at once
+	if !rolledBack {
+		var updatedIDs []uint32
+		for _, result := range results {
+			if result.Success {
+				updatedIDs = append(updatedIDs, result.VocabularyId)
+			}
+		}
+		if len(updatedIDs) > 0 {
+			var updated []models.Vocabulary
+			if err := database.DB.Preload("Tags").Where("id IN ?", updatedIDs).Find(&updated).Error; err != nil {
+				return &proto.BatchVocabulariesResponse{
+					Success: false,
+					Message: "Failed to reload vocabularies",
+				}, err
+			}
+			byID := make(map[uint32]*proto.Vocabulary, len(updated))
+			for i := range updated {
+				byID[uint32(updated[i].ID)] = toProtoVocabulary(&updated[i])
+			}
+			for _, result := range results {
+				if result.Success {
+					result.Vocabulary = byID[result.VocabularyId]
+				}
+			}
+		}
+	}
+
+	return batchResponse(results, rolledBack), nil
+}
+
+// updateGroupKey is the change made by an update that only sets the meaning,
+// example or status. Updates with the same key are written together.
+type updateGroupKey struct {
+	meaning, example, status string
+	// fields says which of meaning, example and status are set
+	fields [3]bool
+}
+
+// BatchDeleteVocabularies implements the BatchDeleteVocabularies RPC method
+func (s *VocabularyServiceImpl) BatchDeleteVocabularies(ctx context.Context, req *proto.BatchDeleteVocabulariesRequest) (*proto.BatchVocabulariesResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.BatchVocabulariesResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.BatchVocabulariesResponse{
+			Success: false,
+			Message: "Access denied: can only delete your own vocabularies",
+		}, nil
+	}
+
+	mode, message := batchMode(req.Mode, len(req.VocabularyIds))
+	if message != "" {
+		return &proto.BatchVocabulariesResponse{
+			Success: false,
+			Message: message,
+		}, nil
+	}
+
+	var existingIDs []uint32
+	if err := database.DB.Model(&models.Vocabulary{}).
+		Where("id IN ? AND user_id = ?", uniqueIDs(req.VocabularyIds), authenticatedUserID).
+		Pluck("id", &existingIDs).Error; err != nil {
+		return &proto.BatchVocabulariesResponse{
+			Success: false,
+			Message: "Database error",
+		}, err
+	}
+	exists := make(map[uint32]bool, len(existingIDs))
+	for _, id := range existingIDs {
+		exists[id] = true
+	}
+
+	// The entries found are moved to the trash with a single statement
+	results := newBatchResults(len(req.VocabularyIds))
+	step := batchStep{}
+	seen := make(map[uint32]bool, len(req.VocabularyIds))
+	for i, id := range req.VocabularyIds {
+		results[i].VocabularyId = id
+		switch {
+		case seen[id]:
+			results[i].Message = "Vocabulary appears more than once in the batch"
+		case !exists[id]:
+			results[i].Message = "Vocabulary not found"
+		default:
+			step.items = append(step.items, i)
+		}
+		seen[id] = true
+	}
+
+	var steps []batchStep
+	if len(existingIDs) > 0 {
+		step.apply = func(tx *gorm.DB) error {
+			if err := tx.Where("id IN ? AND user_id = ?", existingIDs, authenticatedUserID).Delete(&models.Vocabulary{}).Error; err != nil {
+				return err
+			}
+			for _, item := range step.items {
+				results[item].Success = true
+				results[item].Message = "Vocabulary moved to trash"
+			}
+			return nil
+		}
+		steps = append(steps, step)
+	}
+
+	rolledBack, err := runBatch(mode, results, steps, "Failed to delete vocabulary")
+	if err != nil {
+		return &proto.BatchVocabulariesResponse{
+			Success: false,
+			Message: "Failed to delete vocabularies",
+		}, err
+	}
+
+	return batchResponse(results, rolledBack), nil
+}
+
+// batchMode validates the mode and size of a batch, returning the mode to
+// use or a message for the user
+func batchMode(mode string, size int) (string, string) {
+	if size == 0 {
+		return "", "A batch needs at least one item"
+	}
+	if size > maxBatchSize {
+		return "", fmt.Sprintf("A batch can have at most %d items", maxBatchSize)
+	}
+	if mode == "" {
+		mode = batchAllOrNothing
+	}
+	if mode != batchAllOrNothing && mode != batchBestEffort {
+		return "", "Invalid mode. Use all_or_nothing or best_effort"
+	}
+	return mode, ""
+}
+
+// newBatchResults returns a failed result for each of size items, for the
+// steps to fill in
+func newBatchResults(size int) []*proto.BatchItemResult {
+	results := make([]*proto.BatchItemResult, size)
+	for i := range results {
+		results[i] = &proto.BatchItemResult{Index: int32(i)}
+	}
+	return results
+}
+
+// runBatch applies the steps of a batch in one transaction. Items without a
+// step already failed validation.
+//
+// In all or nothing mode, the transaction is rolled back if any item fails,
+// and runBatch reports whether it was. In best effort mode each step runs
+// under a savepoint, so a step the database fails on is undone alone and its
+// items fail with failMessage; the rest of the batch is committed.
+func runBatch(mode string, results []*proto.BatchItemResult, steps []batchStep, failMessage string) (bool, error) {
+	failed := func() bool {
+		for _, result := range results {
+			if !result.Success {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Items that failed validation roll back an all or nothing batch
+	// before it starts
+	stepped := 0
+	for _, step := range steps {
+		stepped += len(step.items)
+	}
+	if mode == batchAllOrNothing && stepped < len(results) {
+		for _, step := range steps {
+			for _, item := range step.items {
+				results[item].Message = batchNotApplied
+			}
+		}
+		return true, nil
+	}
+
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		for _, step := range steps {
+			if mode == batchAllOrNothing {
+				if err := step.apply(tx); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if err := tx.SavePoint(batchSavePoint).Error; err != nil {
+				return err
+			}
+			if err := step.apply(tx); err != nil {
+				log.Printf("Batch step of %d items failed: %v", len(step.items), err)
+				if err := tx.RollbackTo(batchSavePoint).Error; err != nil {
+					return err
+				}
+				for _, item := range step.items {
+					results[item] = &proto.BatchItemResult{
+						Index:        int32(item),
+						Message:      failMessage,
+						VocabularyId: results[item].VocabularyId,
+					}
+				}
+			}
+		}
+
+		if mode == batchAllOrNothing && failed() {
+			return errBatchRolledBack
+		}
+		return nil
+	})
+	if errors.Is(err, errBatchRolledBack) {
+		return true, nil
+	}
+	return false, err
+}
+
+// batchResponse summarises the results of a batch. The items of a rolled
+// back batch that succeeded are reported as not applied.
+func batchResponse(results []*proto.BatchItemResult, rolledBack bool) *proto.BatchVocabulariesResponse {
+	response := &proto.BatchVocabulariesResponse{Results: results}
+	for _, result := range results {
+		switch {
+		case result.Success:
+			response.Succeeded++
+		case result.Message != batchNotApplied:
+			response.Failed++
+		}
+	}
+
+	if rolledBack {
+		for _, result := range results {
+			if result.Success {
+				result.Success = false
+				result.Message = batchNotApplied
+				result.Vocabulary = nil
+				result.Merged = false
+			}
+		}
+		response.Message = fmt.Sprintf("Batch rolled back: %d of %d items failed", response.Failed, len(results))
+		response.Succeeded = 0
+		return response
+	}
+
+	response.Success = true
+	response.Message = fmt.Sprintf("Batch finished: %d succeeded, %d failed", response.Succeeded, response.Failed)
+	return response
+}
@@ -0,0 +1,307 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vocal-tracker/vocabulary-service/database"
+	"github.com/vocal-tracker/vocabulary-service/middleware"
+	"github.com/vocal-tracker/vocabulary-service/models"
+	"github.com/vocal-tracker/vocabulary-service/proto"
+
+	"gorm.io/gorm"
+)
+
+// Number of matching entries returned as a sample of a bulk update
+const (
+	defaultBulkSampleSize = 10
+	maxBulkSampleSize     = 50
+)
+
+// bulkUpdateChunk is the number of entries changed per statement, keeping
+// the bind variables of a statement well under the PostgreSQL limit
+const bulkUpdateChunk = 1000
+
+// UpdateVocabulariesByFilter implements the UpdateVocabulariesByFilter RPC
+// method. The entries matching the filter are found once, at the start of
+// the transaction, so a change that affects the filter, such as a new status
+// or tag, still applies to every entry that matched. With a new status,
+// matching entries whose stage cannot move to it are skipped.
+func (s *VocabularyServiceImpl) UpdateVocabulariesByFilter(ctx context.Context, req *proto.UpdateVocabulariesByFilterRequest) (*proto.UpdateVocabulariesByFilterResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.UpdateVocabulariesByFilterResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.UpdateVocabulariesByFilterResponse{
+			Success: false,
+			Message: "Access denied: can only update your own vocabularies",
+		}, nil
+	}
+
+	filter := req.Filter
+	if filter == nil {
+		filter = &proto.GetVocabulariesRequest{}
+	}
+	if _, err := compileQuery(filter.Q, time.Now()); err != nil {
+		return &proto.UpdateVocabulariesByFilterResponse{
+			Success:       false,
+			Message:       err.Error(),
+			ErrorPosition: int32(err.pos),
+		}, nil
+	}
+	if message, err := validateVocabularyFilter(authenticatedUserID, filter); message != "" || err != nil {
+		return &proto.UpdateVocabulariesByFilterResponse{
+			Success: false,
+			Message: message,
+		}, err
+	}
+
+	machine, err := loadStageMachine(database.DB, uint(authenticatedUserID))
+	if err != nil {
+		return &proto.UpdateVocabulariesByFilterResponse{
+			Success: false,
+			Message: "Failed to fetch stages",
+		}, err
+	}
+
+	changes, message := validateVocabularyChanges(req.Changes, machine)
+	if message != "" {
+		return &proto.UpdateVocabulariesByFilterResponse{
+			Success: false,
+			Message: message,
+		}, nil
+	}
+
+	sampleSize := int(req.SampleSize)
+	if sampleSize <= 0 {
+		sampleSize = defaultBulkSampleSize
+	}
+	if sampleSize > maxBulkSampleSize {
+		sampleSize = maxBulkSampleSize
+	}
+
+	matching := func(db *gorm.DB) *gorm.DB {
+		return db.Model(&models.Vocabulary{}).Scopes(filterVocabularies(authenticatedUserID, filter))
+	}
+
+	// The matching entries the changes apply to
+	movable := func(db *gorm.DB) *gorm.DB {
+		db = db.Scopes(matching)
+		if changes.stage != nil {
+			db = db.Where("vocabularies.stage IN ?", machine.sources(changes.stage))
+		}
+		return db
+	}
+
+	// Samples list the newest entries first, as GetVocabularies does
+	const newestFirst = "vocabularies.created_at DESC, vocabularies.id DESC"
+
+	if req.DryRun {
+		var matched, updatable int64
+		if err := database.DB.Scopes(matching).Count(&matched).Error; err != nil {
+			return &proto.UpdateVocabulariesByFilterResponse{
+				Success: false,
+				Message: "Failed to count vocabularies",
+			}, err
+		}
+		if err := database.DB.Scopes(movable).Count(&updatable).Error; err != nil {
+			return &proto.UpdateVocabulariesByFilterResponse{
+				Success: false,
+				Message: "Failed to count vocabularies",
+			}, err
+		}
+
+		var sample []models.Vocabulary
+		if err := database.DB.Scopes(movable).Preload("Tags").Order(newestFirst).Limit(sampleSize).Find(&sample).Error; err != nil {
+			return &proto.UpdateVocabulariesByFilterResponse{
+				Success: false,
+				Message: "Failed to fetch vocabularies",
+			}, err
+		}
+
+		return &proto.UpdateVocabulariesByFilterResponse{
+			Success: true,
+			Message: fmt.Sprintf("Dry run: %d entries would be updated", updatable) + skippedMessage(matched-updatable, changes),
+			Matched: int32(matched),
+			DryRun:  true,
+			Sample:  toProtoVocabularies(sample),
+			Skipped: int32(matched - updatable),
+		}, nil
+	}
+
+	var matched int64
+	var ids []uint
+	if err := database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Scopes(matching).Count(&matched).Error; err != nil {
+			return err
+		}
+		if err := tx.Scopes(movable).Order(newestFirst).Pluck("vocabularies.id", &ids).Error; err != nil {
+			return err
+		}
+		return changes.apply(tx, authenticatedUserID, ids)
+	}); err != nil {
+		return &proto.UpdateVocabulariesByFilterResponse{
+			Success: false,
+			Message: "Failed to update vocabularies",
+		}, err
+	}
+
+	// The sample is the first matching entries, reloaded with their changes
+	updated := len(ids)
+	var sample []models.Vocabulary
+	if updated > 0 {
+		if err := database.DB.Preload("Tags").Where("id IN ?", ids[:min(sampleSize, updated)]).
+			Order(newestFirst).Find(&sample).Error; err != nil {
+			return &proto.UpdateVocabulariesByFilterResponse{
+				Success: false,
+				Message: "Failed to reload vocabularies",
+			}, err
+		}
+	}
+
+	return &proto.UpdateVocabulariesByFilterResponse{
+		Success: true,
+		Message: fmt.Sprintf("Updated %d entries", updated) + skippedMessage(matched-int64(updated), changes),
+		Matched: int32(matched),
+		Updated: int32(updated),
+		Sample:  toProtoVocabularies(sample),
+		Skipped: int32(matched - int64(updated)),
+	}, nil
+}
+
+// skippedMessage explains why skipped matching entries are left unchanged,
+// or returns an empty string if there are none
+func skippedMessage(skipped int64, changes *vocabularyChanges) string {
+	if skipped == 0 {
+		return ""
+	}
+	return fmt.Sprintf("; %d skipped because their stage cannot move to %q", skipped, changes.stage.Name)
+}
+
+// vocabularyChanges is the validated form of proto.VocabularyChanges
+type vocabularyChanges struct {
+	// stage is the stage named by the new status, or nil to keep the status
+	stage      *models.StatusStage
+	addTags    []string
+	removeTags []string
+}
+
+// validateVocabularyChanges checks the changes of a bulk update, returning
+// a message for the user if they are invalid. A new status must name one of
+// the stages of machine.
+func validateVocabularyChanges(changes *proto.VocabularyChanges, machine *stageMachine) (*vocabularyChanges, string) {
+	if changes == nil {
+		return nil, "No changes given. Set status, add_tags or remove_tags"
+	}
+	var stage *models.StatusStage
+	if changes.Status != "" {
+		var err error
+		if stage, err = machine.resolve(changes.Status); err != nil {
+			return nil, err.Error()
+		}
+	}
+
+	add, err := normalizeTagNames(changes.AddTags)
+	if err != nil {
+		return nil, err.Error()
+	}
+	remove, err := normalizeTagNames(changes.RemoveTags)
+	if err != nil {
+		return nil, err.Error()
+	}
+	if changes.Status == "" && len(add) == 0 && len(remove) == 0 {
+		return nil, "No changes given. Set status, add_tags or remove_tags"
+	}
+
+	removed := make(map[string]bool, len(remove))
+	for _, name := range remove {
+		removed[strings.ToLower(name)] = true
+	}
+	for _, name := range add {
+		if removed[strings.ToLower(name)] {
+			return nil, fmt.Sprintf("Tag %q cannot be both added and removed", name)
+		}
+	}
+
+	return &vocabularyChanges{stage: stage, addTags: add, removeTags: remove}, ""
+}
+
+// apply makes the changes to the user's entries with the given IDs, a chunk
+// at a time. Every entry's updated_at is set, even when only tags change.
+func (c *vocabularyChanges) apply(tx *gorm.DB, userID uint32, ids []uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	tags, err := resolveTags(tx, uint(userID), c.addTags)
+	if err != nil {
+		return err
+	}
+	tagIDs := make([]uint, len(tags))
+	for i, tag := range tags {
+		tagIDs[i] = tag.ID
+	}
+
+	lowered := make([]string, len(c.removeTags))
+	for i, name := range c.removeTags {
+		lowered[i] = strings.ToLower(name)
+	}
+
+	now := time.Now()
+	columns := map[string]interface{}{"updated_at": now, "version": nextVersion}
+	if c.stage != nil {
+		columns["stage"] = c.stage.Name
+		columns["status"] = c.stage.Status
+	}
+
+	for start := 0; start < len(ids); start += bulkUpdateChunk {
+		chunk := ids[start:min(start+bulkUpdateChunk, len(ids))]
+
+		// Status changes are recorded as revisions and transitions of the
+		// entries whose stage is different, before it is overwritten
+		if c.stage != nil {
+			if err := tx.Exec("INSERT INTO vocabulary_revisions (vocabulary_id, user_id, source, changes, created_at) "+
+				"SELECT id, user_id, ?, json_build_array(json_build_object('field', 'status', 'old_value', stage, 'new_value', CAST(? AS text)))::text, ? "+
+				"FROM vocabularies WHERE id IN ? AND stage <> ?",
+				models.RevisionBulkUpdate, c.stage.Name, now, chunk, c.stage.Name).Error; err != nil {
+				return err
+			}
+			if err := tx.Exec("INSERT INTO status_transitions (vocabulary_id, user_id, from_stage, to_stage, from_status, to_status, source, created_at) "+
+				"SELECT id, user_id, stage, ?, status, ?, ?, ? FROM vocabularies WHERE id IN ? AND stage <> ?",
+				c.stage.Name, c.stage.Status, models.RevisionBulkUpdate, now, chunk, c.stage.Name).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Model(&models.Vocabulary{}).Where("id IN ?", chunk).Updates(columns).Error; err != nil {
+			return err
+		}
+
+		// Entries that already have a tag are skipped
+		if len(tagIDs) > 0 {
+			if err := tx.Exec("INSERT INTO "+vocabularyTagsTable+" (vocabulary_id, tag_id) "+
+				"SELECT v.id, t.id FROM vocabularies v CROSS JOIN tags t WHERE v.id IN ? AND t.id IN ? ON CONFLICT DO NOTHING",
+				chunk, tagIDs).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(lowered) > 0 {
+			if err := tx.Exec("DELETE FROM "+vocabularyTagsTable+" WHERE vocabulary_id IN ? "+
+				"AND tag_id IN (SELECT id FROM tags WHERE user_id = ? AND LOWER(name) IN ?)",
+				chunk, userID, lowered).Error; err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,360 @@
+package services
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/vocal-tracker/vocabulary-service/database"
+	"github.com/vocal-tracker/vocabulary-service/middleware"
+	"github.com/vocal-tracker/vocabulary-service/models"
+	"github.com/vocal-tracker/vocabulary-service/proto"
+
+	"gorm.io/gorm"
+)
+
+const (
+	defaultClozeItems = 10
+	maxClozeItems     = 50
+	// clozeBlank replaces the target word in the example sentence
+	clozeBlank = "_____"
+	// clozeCandidates is the number of entries with an example drawn for
+	// each item asked for, since some examples do not contain their word
+	clozeCandidates = 3
+	// maxClozeIssues is the most entries without an example reported
+	maxClozeIssues = 50
+)
+
+// hasExample is the condition that an entry has an example sentence
+const hasExample = "TRIM(COALESCE(example, '')) <> ''"
+
+// GenerateClozeExercises implements the GenerateClozeExercises RPC method
+func (s *VocabularyServiceImpl) GenerateClozeExercises(ctx context.Context, req *proto.GenerateClozeRequest) (*proto.GenerateClozeResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.GenerateClozeResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.GenerateClozeResponse{
+			Success: false,
+			Message: "Access denied: can only practise your own vocabularies",
+		}, nil
+	}
+
+	count := int(req.Count)
+	if count <= 0 {
+		count = defaultClozeItems
+	}
+	if count > maxClozeItems {
+		count = maxClozeItems
+	}
+
+	query := database.DB.Select("id", "word", "meaning", "example").Where("user_id = ?", authenticatedUserID)
+	if req.Status != "" {
+		query = query.Where(statusCondition(req.Status))
+	}
+	query = query.Session(&gorm.Session{})
+
+	// Entries the user should fix: the first ones without an example, then
+	// the candidates below whose example does not contain the word
+	var missing []models.Vocabulary
+	if err := query.Where("NOT (" + hasExample + ")").Order("id").Limit(maxClozeIssues).Find(&missing).Error; err != nil {
+		return &proto.GenerateClozeResponse{
+			Success: false,
+			Message: "Failed to fetch vocabularies",
+		}, err
+	}
+	var unusable []*proto.ClozeIssue
+	for _, entry := range missing {
+		unusable = append(unusable, &proto.ClozeIssue{
+			VocabularyId: uint32(entry.ID),
+			Word:         entry.Word,
+			Reason:       "No example sentence",
+		})
+	}
+
+	// Random candidates with an example, drawn in the database so only a
+	// few entries are loaded however many the user has
+	var candidates []models.Vocabulary
+	if err := query.Where(hasExample).Order("RANDOM()").Limit(count * clozeCandidates).Find(&candidates).Error; err != nil {
+		return &proto.GenerateClozeResponse{
+			Success: false,
+			Message: "Failed to fetch vocabularies",
+		}, err
+	}
+
+	var items []*proto.ClozeItem
+	for _, entry := range candidates {
+		// TRIM leaves other white space, such as line breaks
+		if strings.TrimSpace(entry.Example) == "" {
+			unusable = append(unusable, &proto.ClozeIssue{
+				VocabularyId: uint32(entry.ID),
+				Word:         entry.Word,
+				Reason:       "No example sentence",
+			})
+			continue
+		}
+
+		sentence, expected, ok := makeCloze(entry.Word, entry.Example)
+		if !ok {
+			unusable = append(unusable, &proto.ClozeIssue{
+				VocabularyId: uint32(entry.ID),
+				Word:         entry.Word,
+				Reason:       "Example sentence does not contain the word",
+			})
+			continue
+		}
+
+		if len(items) == count {
+			continue
+		}
+		items = append(items, &proto.ClozeItem{
+			VocabularyId: uint32(entry.ID),
+			Sentence:     sentence,
+			Hint:         entry.Meaning,
+			AnswerLength: int32(utf8.RuneCountInString(expected)),
+		})
+	}
+
+	message := "Cloze exercises generated successfully"
+	if len(items) == 0 {
+		message = "No vocabularies have a usable example sentence"
+	}
+
+	return &proto.GenerateClozeResponse{
+		Success:  true,
+		Message:  message,
+		Items:    items,
+		Unusable: unusable,
+	}, nil
+}
+
+// GradeCloze implements the GradeCloze RPC method
+func (s *VocabularyServiceImpl) GradeCloze(ctx context.Context, req *proto.GradeClozeRequest) (*proto.GradeClozeResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.GradeClozeResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.GradeClozeResponse{
+			Success: false,
+			Message: "Access denied: can only practise your own vocabularies",
+		}, nil
+	}
+
+	if len(req.Answers) == 0 {
+		return &proto.GradeClozeResponse{
+			Success: false,
+			Message: "At least one answer is required",
+		}, nil
+	}
+
+	ids := make([]uint32, len(req.Answers))
+	for i, answer := range req.Answers {
+		ids[i] = answer.VocabularyId
+	}
+
+	var entries []models.Vocabulary
+	if err := database.DB.Select("id", "word", "example").
+		Where("id IN ? AND user_id = ?", ids, authenticatedUserID).
+		Find(&entries).Error; err != nil {
+		return &proto.GradeClozeResponse{
+			Success: false,
+			Message: "Failed to fetch vocabularies",
+		}, err
+	}
+
+	entriesByID := make(map[uint32]*models.Vocabulary, len(entries))
+	for i := range entries {
+		entriesByID[uint32(entries[i].ID)] = &entries[i]
+	}
+
+	results := make([]*proto.ClozeResult, 0, len(req.Answers))
+	correct := 0
+	for _, answer := range req.Answers {
+		entry, ok := entriesByID[answer.VocabularyId]
+		if !ok {
+			return &proto.GradeClozeResponse{
+				Success: false,
+				Message: "Vocabulary not found",
+			}, nil
+		}
+
+		// The expected answer is the form that was blanked out of the example
+		expected := entry.Word
+		if _, form, ok := makeCloze(entry.Word, entry.Example); ok {
+			expected = form
+		}
+
+		distance := levenshtein(normalizeAnswer(answer.Answer), normalizeAnswer(expected))
+		isCorrect := distance <= typoTolerance(expected)
+		if isCorrect {
+			correct++
+		}
+
+		results = append(results, &proto.ClozeResult{
+			VocabularyId: answer.VocabularyId,
+			Correct:      isCorrect,
+			Exact:        distance == 0,
+			Answer:       answer.Answer,
+			Expected:     expected,
+			Distance:     int32(distance),
+		})
+	}
+
+	return &proto.GradeClozeResponse{
+		Success: true,
+		Message: "Cloze answers graded successfully",
+		Correct: int32(correct),
+		Total:   int32(len(results)),
+		Results: results,
+	}, nil
+}
+
+// makeCloze blanks every occurrence of word, including simple inflected
+// forms, in example. It returns the blanked sentence and the form of the
+// word found first, or ok=false if the example does not contain the word.
+func makeCloze(word, example string) (sentence, expected string, ok bool) {
+	forms := wordForms(word)
+	if len(forms) == 0 {
+		return "", "", false
+	}
+
+	quoted := make([]string, len(forms))
+	for i, form := range forms {
+		quoted[i] = regexp.QuoteMeta(form)
+	}
+	pattern := regexp.MustCompile(`(?i)` + strings.Join(quoted, "|"))
+
+	var b strings.Builder
+	last := 0
+	for _, loc := range pattern.FindAllStringIndex(example, -1) {
+		// Only match whole words
+		if r, _ := utf8.DecodeLastRuneInString(example[:loc[0]]); loc[0] > 0 && isWordRune(r) {
+			continue
+		}
+		if r, _ := utf8.DecodeRuneInString(example[loc[1]:]); loc[1] < len(example) && isWordRune(r) {
+			continue
+		}
+
+		if expected == "" {
+			expected = example[loc[0]:loc[1]]
+		}
+		b.WriteString(example[last:loc[0]])
+		b.WriteString(clozeBlank)
+		last = loc[1]
+	}
+	if expected == "" {
+		return "", "", false
+	}
+	b.WriteString(example[last:])
+
+	return b.String(), expected, true
+}
+
+// wordForms returns word and its simple inflected forms (-s, -es, -ed, -ing
+// and their common spelling changes), longest first. For a phrase only the
+// first word is inflected.
+func wordForms(word string) []string {
+	fields := strings.Fields(strings.ToLower(word))
+	if len(fields) == 0 {
+		return nil
+	}
+	head, rest := fields[0], ""
+	if len(fields) > 1 {
+		rest = " " + strings.Join(fields[1:], " ")
+	}
+
+	stems := map[string]bool{head: true}
+	for _, suffix := range []string{"s", "es", "ed", "d", "ing"} {
+		stems[head+suffix] = true
+	}
+
+	runes := []rune(head)
+	n := len(runes)
+	if n > 2 && runes[n-1] == 'e' {
+		// make -> making
+		stems[string(runes[:n-1])+"ing"] = true
+	}
+	if n > 2 && runes[n-1] == 'y' && !isVowel(runes[n-2]) {
+		// study -> studies, studied
+		stems[string(runes[:n-1])+"ies"] = true
+		stems[string(runes[:n-1])+"ied"] = true
+	}
+	if n > 2 && !isVowel(runes[n-1]) && !strings.ContainsRune("wxy", runes[n-1]) &&
+		isVowel(runes[n-2]) && !isVowel(runes[n-3]) {
+		// stop -> stopped, stopping
+		stems[head+string(runes[n-1])+"ed"] = true
+		stems[head+string(runes[n-1])+"ing"] = true
+	}
+
+	forms := make([]string, 0, len(stems))
+	for stem := range stems {
+		forms = append(forms, stem+rest)
+	}
+	// Longest first so the regexp prefers "walking" over "walk"
+	sort.Slice(forms, func(i, j int) bool {
+		if len(forms[i]) != len(forms[j]) {
+			return len(forms[i]) > len(forms[j])
+		}
+		return forms[i] < forms[j]
+	})
+	return forms
+}
+
+// typoTolerance returns the edit distance accepted for an answer
+func typoTolerance(expected string) int {
+	switch n := utf8.RuneCountInString(expected); {
+	case n <= 4:
+		return 0
+	case n <= 8:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// levenshtein returns the edit distance between a and b
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+func isVowel(r rune) bool {
+	return strings.ContainsRune("aeiou", r)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '\''
+}
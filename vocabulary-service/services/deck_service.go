@@ -0,0 +1,676 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/vocal-tracker/vocabulary-service/database"
+	"github.com/vocal-tracker/vocabulary-service/middleware"
+	"github.com/vocal-tracker/vocabulary-service/models"
+	"github.com/vocal-tracker/vocabulary-service/proto"
+
+	"gorm.io/gorm"
+)
+
+const (
+	maxDeckNameLength = 100
+	// vocabularyDecksTable is the join table between vocabularies and decks
+	vocabularyDecksTable = "vocabulary_decks"
+)
+
+// CreateDeck implements the CreateDeck RPC method
+func (s *VocabularyServiceImpl) CreateDeck(ctx context.Context, req *proto.CreateDeckRequest) (*proto.DeckResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.DeckResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.DeckResponse{
+			Success: false,
+			Message: "Access denied: can only create decks for your own account",
+		}, nil
+	}
+
+	deck := models.Deck{
+		UserID:            uint(authenticatedUserID),
+		Name:              strings.TrimSpace(req.Name),
+		Description:       strings.TrimSpace(req.Description),
+		SourceLanguage:    strings.TrimSpace(req.SourceLanguage),
+		TargetLanguage:    strings.TrimSpace(req.TargetLanguage),
+		NewCardsPerDay:    int(req.NewCardsPerDay),
+		ReviewCardsPerDay: int(req.ReviewCardsPerDay),
+	}
+	if message, err := validateDeck(&deck); message != "" || err != nil {
+		return &proto.DeckResponse{
+			Success: false,
+			Message: message,
+		}, err
+	}
+
+	if err := database.DB.Create(&deck).Error; err != nil {
+		return &proto.DeckResponse{
+			Success: false,
+			Message: "Failed to create deck",
+		}, err
+	}
+
+	return &proto.DeckResponse{
+		Success: true,
+		Message: "Deck created successfully",
+		Deck:    toProtoDeck(&deck, 0),
+	}, nil
+}
+
+// GetDecks implements the GetDecks RPC method
+func (s *VocabularyServiceImpl) GetDecks(ctx context.Context, req *proto.GetDecksRequest) (*proto.GetDecksResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.GetDecksResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.GetDecksResponse{
+			Success: false,
+			Message: "Access denied: can only access your own decks",
+		}, nil
+	}
+
+	var results []struct {
+		models.Deck
+		VocabularyCount int64
+	}
+	if err := database.DB.Model(&models.Deck{}).
+		Select("decks.*, count(vd.vocabulary_id) as vocabulary_count").
+		Joins("LEFT JOIN "+vocabularyDecksTable+" vd ON vd.deck_id = decks.id AND "+notTrashed("vd.vocabulary_id")).
+		Where("decks.user_id = ?", authenticatedUserID).
+		Group("decks.id").
+		Order("LOWER(decks.name)").
+		Scan(&results).Error; err != nil {
+		return &proto.GetDecksResponse{
+			Success: false,
+			Message: "Failed to fetch decks",
+		}, err
+	}
+
+	decks := make([]*proto.Deck, len(results))
+	for i := range results {
+		decks[i] = toProtoDeck(&results[i].Deck, results[i].VocabularyCount)
+	}
+
+	return &proto.GetDecksResponse{
+		Success: true,
+		Message: "Decks retrieved successfully",
+		Decks:   decks,
+	}, nil
+}
+
+// GetDeckById implements the GetDeckById RPC method
+func (s *VocabularyServiceImpl) GetDeckById(ctx context.Context, req *proto.DeckRequest) (*proto.DeckResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.DeckResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.DeckResponse{
+			Success: false,
+			Message: "Access denied: can only access your own decks",
+		}, nil
+	}
+
+	deck, err := findDeck(authenticatedUserID, req.DeckId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &proto.DeckResponse{
+				Success: false,
+				Message: "Deck not found",
+			}, nil
+		}
+		return &proto.DeckResponse{
+			Success: false,
+			Message: "Database error",
+		}, err
+	}
+
+	return &proto.DeckResponse{
+		Success: true,
+		Message: "Deck retrieved successfully",
+		Deck:    toProtoDeck(deck, deckVocabularyCount(deck.ID)),
+	}, nil
+}
+
+// UpdateDeck implements the UpdateDeck RPC method
+func (s *VocabularyServiceImpl) UpdateDeck(ctx context.Context, req *proto.UpdateDeckRequest) (*proto.DeckResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.DeckResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.DeckResponse{
+			Success: false,
+			Message: "Access denied: can only update your own decks",
+		}, nil
+	}
+
+	deck, err := findDeck(authenticatedUserID, req.DeckId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &proto.DeckResponse{
+				Success: false,
+				Message: "Deck not found",
+			}, nil
+		}
+		return &proto.DeckResponse{
+			Success: false,
+			Message: "Database error",
+		}, err
+	}
+
+	// Update fields if provided
+	if name := strings.TrimSpace(req.Name); name != "" {
+		deck.Name = name
+	}
+	if req.Description != "" {
+		deck.Description = strings.TrimSpace(req.Description)
+	}
+	if req.SourceLanguage != "" {
+		deck.SourceLanguage = strings.TrimSpace(req.SourceLanguage)
+	}
+	if req.TargetLanguage != "" {
+		deck.TargetLanguage = strings.TrimSpace(req.TargetLanguage)
+	}
+	if req.NewCardsPerDay != nil {
+		deck.NewCardsPerDay = int(*req.NewCardsPerDay)
+	}
+	if req.ReviewCardsPerDay != nil {
+		deck.ReviewCardsPerDay = int(*req.ReviewCardsPerDay)
+	}
+	if message, err := validateDeck(deck); message != "" || err != nil {
+		return &proto.DeckResponse{
+			Success: false,
+			Message: message,
+		}, err
+	}
+
+	if err := database.DB.Model(deck).Updates(map[string]interface{}{
+		"name":                 deck.Name,
+		"description":          deck.Description,
+		"source_language":      deck.SourceLanguage,
+		"target_language":      deck.TargetLanguage,
+		"new_cards_per_day":    deck.NewCardsPerDay,
+		"review_cards_per_day": deck.ReviewCardsPerDay,
+	}).Error; err != nil {
+		return &proto.DeckResponse{
+			Success: false,
+			Message: "Failed to update deck",
+		}, err
+	}
+
+	return &proto.DeckResponse{
+		Success: true,
+		Message: "Deck updated successfully",
+		Deck:    toProtoDeck(deck, deckVocabularyCount(deck.ID)),
+	}, nil
+}
+
+// DeleteDeck implements the DeleteDeck RPC method
+func (s *VocabularyServiceImpl) DeleteDeck(ctx context.Context, req *proto.DeckRequest) (*proto.DeleteDeckResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.DeleteDeckResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.DeleteDeckResponse{
+			Success: false,
+			Message: "Access denied: can only delete your own decks",
+		}, nil
+	}
+
+	// Entries keep existing; the join rows are removed by the cascade
+	result := database.DB.Where("id = ? AND user_id = ?", req.DeckId, authenticatedUserID).Delete(&models.Deck{})
+	if result.Error != nil {
+		return &proto.DeleteDeckResponse{
+			Success: false,
+			Message: "Failed to delete deck",
+		}, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return &proto.DeleteDeckResponse{
+			Success: false,
+			Message: "Deck not found",
+		}, nil
+	}
+
+	return &proto.DeleteDeckResponse{
+		Success: true,
+		Message: "Deck deleted successfully",
+	}, nil
+}
+
+// AddVocabulariesToDeck implements the AddVocabulariesToDeck RPC method
+func (s *VocabularyServiceImpl) AddVocabulariesToDeck(ctx context.Context, req *proto.DeckVocabulariesRequest) (*proto.DeckVocabulariesResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.DeckVocabulariesResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.DeckVocabulariesResponse{
+			Success: false,
+			Message: "Access denied: can only change your own decks",
+		}, nil
+	}
+
+	if len(req.VocabularyIds) == 0 {
+		return &proto.DeckVocabulariesResponse{
+			Success: false,
+			Message: "At least one vocabulary ID is required",
+		}, nil
+	}
+
+	if _, err := findDeck(authenticatedUserID, req.DeckId); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &proto.DeckVocabulariesResponse{
+				Success: false,
+				Message: "Deck not found",
+			}, nil
+		}
+		return &proto.DeckVocabulariesResponse{
+			Success: false,
+			Message: "Database error",
+		}, err
+	}
+
+	// Every entry must belong to the user
+	var owned int64
+	database.DB.Model(&models.Vocabulary{}).
+		Where("id IN ? AND user_id = ?", req.VocabularyIds, authenticatedUserID).
+		Count(&owned)
+	if int(owned) != len(uniqueIDs(req.VocabularyIds)) {
+		return &proto.DeckVocabulariesResponse{
+			Success: false,
+			Message: "Vocabulary not found",
+		}, nil
+	}
+
+	// Entries already in the deck are skipped
+	result := database.DB.Exec("INSERT INTO "+vocabularyDecksTable+" (vocabulary_id, deck_id) "+
+		"SELECT id, ? FROM vocabularies WHERE id IN ? AND user_id = ? AND deleted_at IS NULL ON CONFLICT DO NOTHING",
+		req.DeckId, req.VocabularyIds, authenticatedUserID)
+	if result.Error != nil {
+		return &proto.DeckVocabulariesResponse{
+			Success: false,
+			Message: "Failed to add vocabularies to deck",
+		}, result.Error
+	}
+
+	return &proto.DeckVocabulariesResponse{
+		Success:  true,
+		Message:  "Vocabularies added to deck successfully",
+		Affected: int32(result.RowsAffected),
+	}, nil
+}
+
+// RemoveVocabulariesFromDeck implements the RemoveVocabulariesFromDeck RPC method
+func (s *VocabularyServiceImpl) RemoveVocabulariesFromDeck(ctx context.Context, req *proto.DeckVocabulariesRequest) (*proto.DeckVocabulariesResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.DeckVocabulariesResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.DeckVocabulariesResponse{
+			Success: false,
+			Message: "Access denied: can only change your own decks",
+		}, nil
+	}
+
+	if len(req.VocabularyIds) == 0 {
+		return &proto.DeckVocabulariesResponse{
+			Success: false,
+			Message: "At least one vocabulary ID is required",
+		}, nil
+	}
+
+	if _, err := findDeck(authenticatedUserID, req.DeckId); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &proto.DeckVocabulariesResponse{
+				Success: false,
+				Message: "Deck not found",
+			}, nil
+		}
+		return &proto.DeckVocabulariesResponse{
+			Success: false,
+			Message: "Database error",
+		}, err
+	}
+
+	result := database.DB.Exec("DELETE FROM "+vocabularyDecksTable+" WHERE deck_id = ? AND vocabulary_id IN ?",
+		req.DeckId, req.VocabularyIds)
+	if result.Error != nil {
+		return &proto.DeckVocabulariesResponse{
+			Success: false,
+			Message: "Failed to remove vocabularies from deck",
+		}, result.Error
+	}
+
+	return &proto.DeckVocabulariesResponse{
+		Success:  true,
+		Message:  "Vocabularies removed from deck successfully",
+		Affected: int32(result.RowsAffected),
+	}, nil
+}
+
+// MoveVocabularies implements the MoveVocabularies RPC method
+func (s *VocabularyServiceImpl) MoveVocabularies(ctx context.Context, req *proto.TransferVocabulariesRequest) (*proto.DeckVocabulariesResponse, error) {
+	return s.transferVocabularies(ctx, req, true)
+}
+
+// CopyVocabularies implements the CopyVocabularies RPC method
+func (s *VocabularyServiceImpl) CopyVocabularies(ctx context.Context, req *proto.TransferVocabulariesRequest) (*proto.DeckVocabulariesResponse, error) {
+	return s.transferVocabularies(ctx, req, false)
+}
+
+// transferVocabularies adds entries of the source deck to the target deck.
+// When move is set they are also removed from the source deck. Entries are
+// shared between decks rather than duplicated.
+func (s *VocabularyServiceImpl) transferVocabularies(ctx context.Context, req *proto.TransferVocabulariesRequest, move bool) (*proto.DeckVocabulariesResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.DeckVocabulariesResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.DeckVocabulariesResponse{
+			Success: false,
+			Message: "Access denied: can only change your own decks",
+		}, nil
+	}
+
+	if req.SourceDeckId == req.TargetDeckId {
+		return &proto.DeckVocabulariesResponse{
+			Success: false,
+			Message: "Source and target decks must be different",
+		}, nil
+	}
+
+	for _, deckID := range []uint32{req.SourceDeckId, req.TargetDeckId} {
+		if _, err := findDeck(authenticatedUserID, deckID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return &proto.DeckVocabulariesResponse{
+					Success: false,
+					Message: fmt.Sprintf("Deck %d not found", deckID),
+				}, nil
+			}
+			return &proto.DeckVocabulariesResponse{
+				Success: false,
+				Message: "Database error",
+			}, err
+		}
+	}
+
+	// Without explicit IDs the whole source deck is transferred
+	members := database.DB.Table(vocabularyDecksTable).Select("vocabulary_id").Where("deck_id = ?", req.SourceDeckId)
+	if len(req.VocabularyIds) > 0 {
+		members = members.Where("vocabulary_id IN ?", req.VocabularyIds)
+	}
+
+	var affected int64
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Exec("INSERT INTO "+vocabularyDecksTable+" (vocabulary_id, deck_id) "+
+			"SELECT vocabulary_id, ? FROM (?) AS members ON CONFLICT DO NOTHING",
+			req.TargetDeckId, members)
+		if result.Error != nil {
+			return result.Error
+		}
+		affected = result.RowsAffected
+		if !move {
+			return nil
+		}
+
+		remove := tx.Table(vocabularyDecksTable).Where("deck_id = ?", req.SourceDeckId)
+		if len(req.VocabularyIds) > 0 {
+			remove = remove.Where("vocabulary_id IN ?", req.VocabularyIds)
+		}
+		result = remove.Delete(nil)
+		affected = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		return &proto.DeckVocabulariesResponse{
+			Success: false,
+			Message: "Failed to transfer vocabularies",
+		}, err
+	}
+
+	message := "Vocabularies copied successfully"
+	if move {
+		message = "Vocabularies moved successfully"
+	}
+
+	return &proto.DeckVocabulariesResponse{
+		Success:  true,
+		Message:  message,
+		Affected: int32(affected),
+	}, nil
+}
+
+// GetDeckStats implements the GetDeckStats RPC method
+func (s *VocabularyServiceImpl) GetDeckStats(ctx context.Context, req *proto.DeckRequest) (*proto.DeckStatsResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.DeckStatsResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.DeckStatsResponse{
+			Success: false,
+			Message: "Access denied: can only access your own decks",
+		}, nil
+	}
+
+	deck, err := findDeck(authenticatedUserID, req.DeckId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &proto.DeckStatsResponse{
+				Success: false,
+				Message: "Deck not found",
+			}, nil
+		}
+		return &proto.DeckStatsResponse{
+			Success: false,
+			Message: "Database error",
+		}, err
+	}
+
+	// Status counts
+	statusCounts := make(map[string]int32)
+	var statusResults []struct {
+		Status string
+		Count  int64
+	}
+	database.DB.Model(&models.Vocabulary{}).
+		Select("status, count(*) as count").
+		Scopes(deckScope(req.DeckId)).
+		Group("status").
+		Find(&statusResults)
+
+	var totalWords int64
+	for _, result := range statusResults {
+		statusCounts[result.Status] = int32(result.Count)
+		totalWords += result.Count
+	}
+
+	var dueCount, newCount int64
+	database.DB.Model(&models.Vocabulary{}).
+		Scopes(deckScope(req.DeckId)).
+		Where("next_review_at <= ?", time.Now()).
+		Count(&dueCount)
+	database.DB.Model(&models.Vocabulary{}).
+		Scopes(deckScope(req.DeckId)).
+		Where("next_review_at IS NULL").
+		Count(&newCount)
+
+	var reviewSummary struct {
+		Total    int64
+		Failures int64
+	}
+	database.DB.Model(&models.ReviewAttempt{}).
+		Select("count(*) as total, count(*) filter (where grade = ?) as failures", gradeAgain).
+		Where("vocabulary_id IN (?)", deckMembers(req.DeckId)).
+		Scopes(excludeTrashed("vocabulary_id")).
+		Scan(&reviewSummary)
+
+	return &proto.DeckStatsResponse{
+		Success:      true,
+		Message:      "Deck statistics retrieved successfully",
+		Deck:         toProtoDeck(deck, totalWords),
+		TotalWords:   int32(totalWords),
+		StatusCounts: statusCounts,
+		DueCount:     int32(dueCount),
+		NewCount:     int32(newCount),
+		TotalReviews: int32(reviewSummary.Total),
+		AccuracyRate: accuracyRate(reviewSummary.Total, reviewSummary.Failures),
+	}, nil
+}
+
+// validateDeck checks a deck's fields, returning a message for the user if
+// they are invalid
+func validateDeck(deck *models.Deck) (string, error) {
+	if deck.Name == "" {
+		return "Deck name is required", nil
+	}
+	if utf8.RuneCountInString(deck.Name) > maxDeckNameLength {
+		return fmt.Sprintf("Deck name must be at most %d characters", maxDeckNameLength), nil
+	}
+	if deck.NewCardsPerDay < 0 || deck.ReviewCardsPerDay < 0 {
+		return "Daily card limits cannot be negative", nil
+	}
+
+	// Deck names are unique per user, ignoring case
+	var count int64
+	if err := database.DB.Model(&models.Deck{}).
+		Where("user_id = ? AND LOWER(name) = LOWER(?) AND id <> ?", deck.UserID, deck.Name, deck.ID).
+		Count(&count).Error; err != nil {
+		return "Database error", err
+	}
+	if count > 0 {
+		return fmt.Sprintf("A deck named %q already exists", deck.Name), nil
+	}
+	return "", nil
+}
+
+// findDeck returns the user's deck with the given ID
+func findDeck(userID, deckID uint32) (*models.Deck, error) {
+	var deck models.Deck
+	if err := database.DB.Where("id = ? AND user_id = ?", deckID, userID).First(&deck).Error; err != nil {
+		return nil, err
+	}
+	return &deck, nil
+}
+
+// deckMembers returns a subquery selecting the IDs of the entries in a deck
+func deckMembers(deckID uint32) *gorm.DB {
+	return database.DB.Table(vocabularyDecksTable).Select("vocabulary_id").Where("deck_id = ?", deckID)
+}
+
+// deckScope limits a vocabulary query to the entries in a deck. A zero
+// deck ID leaves the query unchanged.
+func deckScope(deckID uint32) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if deckID == 0 {
+			return db
+		}
+		return db.Where("vocabularies.id IN (?)", deckMembers(deckID))
+	}
+}
+
+// deckVocabularyCount returns the number of entries in a deck
+func deckVocabularyCount(deckID uint) int64 {
+	var count int64
+	database.DB.Table(vocabularyDecksTable).Scopes(excludeTrashed("vocabulary_id")).Where("deck_id = ?", deckID).Count(&count)
+	return count
+}
+
+// uniqueIDs returns ids without duplicates
+func uniqueIDs(ids []uint32) []uint32 {
+	seen := make(map[uint32]bool, len(ids))
+	unique := make([]uint32, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+// toProtoDeck converts a deck model to its proto representation
+func toProtoDeck(deck *models.Deck, vocabularyCount int64) *proto.Deck {
+	return &proto.Deck{
+		Id:                uint32(deck.ID),
+		UserId:            uint32(deck.UserID),
+		Name:              deck.Name,
+		Description:       deck.Description,
+		SourceLanguage:    deck.SourceLanguage,
+		TargetLanguage:    deck.TargetLanguage,
+		NewCardsPerDay:    int32(deck.NewCardsPerDay),
+		ReviewCardsPerDay: int32(deck.ReviewCardsPerDay),
+		VocabularyCount:   int32(vocabularyCount),
+		CreatedAt:         deck.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:         deck.UpdatedAt.Format(time.RFC3339),
+	}
+}
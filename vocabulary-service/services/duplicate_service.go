@@ -0,0 +1,256 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/vocal-tracker/vocabulary-service/database"
+	"github.com/vocal-tracker/vocabulary-service/middleware"
+	"github.com/vocal-tracker/vocabulary-service/models"
+	"github.com/vocal-tracker/vocabulary-service/proto"
+
+	"gorm.io/gorm"
+)
+
+// What CreateVocabulary does when the word already exists
+const (
+	duplicateReject = "reject"
+	duplicateMerge  = "merge"
+)
+
+// FindDuplicates implements the FindDuplicates RPC method
+func (s *VocabularyServiceImpl) FindDuplicates(ctx context.Context, req *proto.FindDuplicatesRequest) (*proto.FindDuplicatesResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.FindDuplicatesResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.FindDuplicatesResponse{
+			Success: false,
+			Message: "Access denied: can only access your own vocabularies",
+		}, nil
+	}
+
+	var words []string
+	if err := database.DB.Model(&models.Vocabulary{}).
+		Where("user_id = ? AND normalized_word <> ''", authenticatedUserID).
+		Group("normalized_word").
+		Having("count(*) > 1").
+		Order("normalized_word").
+		Pluck("normalized_word", &words).Error; err != nil {
+		return &proto.FindDuplicatesResponse{
+			Success: false,
+			Message: "Failed to find duplicates",
+		}, err
+	}
+
+	var entries []models.Vocabulary
+	if len(words) > 0 {
+		if err := database.DB.Preload("Tags").
+			Where("user_id = ? AND normalized_word IN ?", authenticatedUserID, words).
+			Order("normalized_word, created_at").
+			Find(&entries).Error; err != nil {
+			return &proto.FindDuplicatesResponse{
+				Success: false,
+				Message: "Failed to fetch vocabularies",
+			}, err
+		}
+	}
+
+	// Entries are sorted by word, so each group is a consecutive run
+	var groups []*proto.DuplicateGroup
+	for i := range entries {
+		if len(groups) == 0 || groups[len(groups)-1].NormalizedWord != entries[i].NormalizedWord {
+			groups = append(groups, &proto.DuplicateGroup{NormalizedWord: entries[i].NormalizedWord})
+		}
+		group := groups[len(groups)-1]
+		group.Vocabularies = append(group.Vocabularies, toProtoVocabulary(&entries[i]))
+	}
+
+	message := "Duplicates retrieved successfully"
+	if len(groups) == 0 {
+		message = "No duplicates found"
+	}
+
+	return &proto.FindDuplicatesResponse{
+		Success: true,
+		Message: message,
+		Groups:  groups,
+	}, nil
+}
+
+// MergeVocabularies implements the MergeVocabularies RPC method
+func (s *VocabularyServiceImpl) MergeVocabularies(ctx context.Context, req *proto.MergeVocabulariesRequest) (*proto.VocabularyResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Access denied: can only merge your own vocabularies",
+		}, nil
+	}
+
+	sourceIDs := uniqueIDs(req.SourceIds)
+	if len(sourceIDs) == 0 {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "At least one source vocabulary is required",
+		}, nil
+	}
+	for _, id := range sourceIDs {
+		if id == req.TargetId {
+			return &proto.VocabularyResponse{
+				Success: false,
+				Message: "Cannot merge a vocabulary into itself",
+			}, nil
+		}
+	}
+
+	var target models.Vocabulary
+	if err := database.DB.Where("id = ? AND user_id = ?", req.TargetId, authenticatedUserID).First(&target).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &proto.VocabularyResponse{
+				Success: false,
+				Message: "Vocabulary not found",
+			}, nil
+		}
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Database error",
+		}, err
+	}
+
+	var sources []models.Vocabulary
+	if err := database.DB.Preload("Tags").Preload("Decks").
+		Where("id IN ? AND user_id = ?", sourceIDs, authenticatedUserID).
+		Order("created_at").
+		Find(&sources).Error; err != nil {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Failed to fetch vocabularies",
+		}, err
+	}
+	if len(sources) != len(sourceIDs) {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Vocabulary not found",
+		}, nil
+	}
+
+	// The target keeps its own schedule and status; the sources' review
+	// history moves over to it before they are deleted
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		for i := range sources {
+			if err := mergeVocabularyInto(tx, &target, &sources[i]); err != nil {
+				return err
+			}
+		}
+		if err := tx.Model(&models.ReviewAttempt{}).
+			Where("vocabulary_id IN ?", sourceIDs).
+			Update("vocabulary_id", target.ID).Error; err != nil {
+			return err
+		}
+		// The sources live on in the target, so they skip the trash
+		return tx.Unscoped().Where("id IN ? AND user_id = ?", sourceIDs, authenticatedUserID).Delete(&models.Vocabulary{}).Error
+	})
+	if err != nil {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Failed to merge vocabularies",
+		}, err
+	}
+
+	// Reload the merged vocabulary
+	if err := database.DB.Preload("Tags").First(&target, target.ID).Error; err != nil {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Failed to reload vocabulary",
+		}, err
+	}
+
+	return &proto.VocabularyResponse{
+		Success:    true,
+		Message:    fmt.Sprintf("Merged %d vocabularies successfully", len(sources)),
+		Vocabulary: toProtoVocabulary(&target),
+		Merged:     true,
+	}, nil
+}
+
+// findDuplicate returns the user's oldest entry with the given normalised
+// word, ignoring excludeID, or nil if there is none
+func findDuplicate(tx *gorm.DB, userID uint, normalizedWord string, excludeID uint) (*models.Vocabulary, error) {
+	var vocab models.Vocabulary
+	err := tx.Preload("Tags").
+		Where("user_id = ? AND normalized_word = ? AND id <> ?", userID, normalizedWord, excludeID).
+		Order("created_at").
+		First(&vocab).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &vocab, nil
+}
+
+// mergeVocabularyInto adds the meaning, example, tags and decks of source to
+// target. The source itself is left untouched.
+func mergeVocabularyInto(tx *gorm.DB, target, source *models.Vocabulary) error {
+	updates := make(map[string]interface{})
+	if meaning := mergeText(target.Meaning, source.Meaning, "; "); meaning != target.Meaning {
+		updates["meaning"] = meaning
+	}
+	if example := mergeText(target.Example, source.Example, "\n"); example != target.Example {
+		updates["example"] = example
+	}
+	if _, err := saveVocabularyColumns(tx, target, updates, models.RevisionMerge); err != nil {
+		return err
+	}
+
+	if len(source.Tags) > 0 {
+		if err := tx.Model(target).Association("Tags").Append(source.Tags); err != nil {
+			return err
+		}
+		// New tags alone are a new version too
+		if len(updates) == 0 {
+			if err := tx.Model(target).Update("version", nextVersion).Error; err != nil {
+				return err
+			}
+		}
+	}
+	if len(source.Decks) > 0 {
+		if err := tx.Model(target).Association("Decks").Append(source.Decks); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeText appends incoming to existing with sep, unless incoming is empty
+// or already part of existing
+func mergeText(existing, incoming, sep string) string {
+	incoming = strings.TrimSpace(incoming)
+	if incoming == "" || strings.Contains(normalizeAnswer(existing), normalizeAnswer(incoming)) {
+		return existing
+	}
+	if strings.TrimSpace(existing) == "" {
+		return incoming
+	}
+	return existing + sep + incoming
+}
@@ -0,0 +1,257 @@
+package services
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/vocal-tracker/vocabulary-service/database"
+	"github.com/vocal-tracker/vocabulary-service/middleware"
+	"github.com/vocal-tracker/vocabulary-service/models"
+	"github.com/vocal-tracker/vocabulary-service/proto"
+
+	"gorm.io/gorm"
+)
+
+// Export formats besides the import formats
+const (
+	exportFormatAnki = "anki"
+	exportFormatJSON = "json"
+)
+
+const (
+	// exportBatchSize is the number of entries read from the database at a time
+	exportBatchSize = 500
+	// exportChunkSize is the size of the file chunks streamed to the client
+	exportChunkSize = 32 << 10
+)
+
+// exportColumns are the columns of CSV and TSV exports, in the order
+// ImportVocabularies reads them back
+var exportColumns = []string{"word", "meaning", "example", "date", "status", "tags"}
+
+// ExportVocabularies implements the ExportVocabularies RPC method
+func (s *VocabularyServiceImpl) ExportVocabularies(req *proto.ExportVocabulariesRequest, stream proto.VocabularyService_ExportVocabulariesServer) error {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(stream.Context())
+	if err != nil {
+		return stream.Send(&proto.ExportVocabulariesResponse{
+			Success: false,
+			Message: "Authentication required",
+		})
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return stream.Send(&proto.ExportVocabulariesResponse{
+			Success: false,
+			Message: "Access denied: can only export your own vocabularies",
+		})
+	}
+
+	format := strings.ToLower(req.Format)
+	if format == "" {
+		format = importFormatCSV
+	}
+	if format != importFormatCSV && format != importFormatTSV && format != exportFormatAnki && format != exportFormatJSON {
+		return stream.Send(&proto.ExportVocabulariesResponse{
+			Success: false,
+			Message: "Invalid format. Use csv, tsv, anki or json",
+		})
+	}
+
+	filter := req.Filter
+	if filter == nil {
+		filter = &proto.GetVocabulariesRequest{}
+	}
+	if message, err := validateVocabularyFilter(authenticatedUserID, filter); message != "" || err != nil {
+		if err != nil {
+			return err
+		}
+		return stream.Send(&proto.ExportVocabulariesResponse{
+			Success: false,
+			Message: message,
+		})
+	}
+
+	out := bufio.NewWriterSize(&exportWriter{stream: stream}, exportChunkSize)
+	encoder := newExportEncoder(format, out)
+	if err := encoder.writeHeader(); err != nil {
+		return err
+	}
+
+	// Entries are read in batches so large collections are never held in memory at once
+	var batch []models.Vocabulary
+	err = database.DB.Preload("Tags").
+		Scopes(filterVocabularies(authenticatedUserID, filter)).
+		FindInBatches(&batch, exportBatchSize, func(tx *gorm.DB, _ int) error {
+			for i := range batch {
+				if err := encoder.writeEntry(&batch[i]); err != nil {
+					return err
+				}
+			}
+			return nil
+		}).Error
+	if err != nil {
+		return err
+	}
+
+	if err := encoder.close(); err != nil {
+		return err
+	}
+	return out.Flush()
+}
+
+// exportEncoder writes vocabularies in one export format
+type exportEncoder interface {
+	writeHeader() error
+	writeEntry(vocab *models.Vocabulary) error
+	close() error
+}
+
+func newExportEncoder(format string, w io.Writer) exportEncoder {
+	switch format {
+	case exportFormatAnki:
+		writer := csv.NewWriter(w)
+		writer.Comma = '\t'
+		return &ankiEncoder{w: w, writer: writer}
+	case exportFormatJSON:
+		return &jsonEncoder{w: w}
+	default:
+		writer := csv.NewWriter(w)
+		if format == importFormatTSV {
+			writer.Comma = '\t'
+		}
+		return &delimitedEncoder{writer: writer}
+	}
+}
+
+// delimitedEncoder writes CSV or TSV with a header row, in the layout
+// ImportVocabularies accepts
+type delimitedEncoder struct {
+	writer *csv.Writer
+}
+
+func (e *delimitedEncoder) writeHeader() error {
+	return e.writer.Write(exportColumns)
+}
+
+func (e *delimitedEncoder) writeEntry(vocab *models.Vocabulary) error {
+	return e.writer.Write([]string{
+		vocab.Word,
+		vocab.Meaning,
+		vocab.Example,
+		vocab.Date.Format("2006-01-02"),
+		vocab.Stage, // The status column takes a stage on import
+		strings.Join(tagNames(vocab.Tags), ";"),
+	})
+}
+
+func (e *delimitedEncoder) close() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+// ankiEncoder writes an Anki notes text file for the Basic note type: the
+// word on the front, the meaning and example on the back, then the tags
+type ankiEncoder struct {
+	w      io.Writer
+	writer *csv.Writer
+}
+
+func (e *ankiEncoder) writeHeader() error {
+	_, err := io.WriteString(e.w, "#separator:tab\n#html:false\n#notetype:Basic\n#tags column:3\n")
+	return err
+}
+
+func (e *ankiEncoder) writeEntry(vocab *models.Vocabulary) error {
+	back := vocab.Meaning
+	if vocab.Example != "" {
+		back += "\n\n" + vocab.Example
+	}
+
+	// Anki separates tags with spaces, so spaces inside a tag become underscores
+	tags := tagNames(vocab.Tags)
+	for i, tag := range tags {
+		tags[i] = strings.ReplaceAll(tag, " ", "_")
+	}
+	return e.writer.Write([]string{vocab.Word, back, strings.Join(tags, " ")})
+}
+
+func (e *ankiEncoder) close() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+// jsonEncoder writes a JSON array of entries
+type jsonEncoder struct {
+	w     io.Writer
+	count int
+}
+
+// exportEntry is the JSON form of an exported vocabulary
+type exportEntry struct {
+	Word      string   `json:"word"`
+	Meaning   string   `json:"meaning"`
+	Example   string   `json:"example"`
+	Date      string   `json:"date"`
+	Status    string   `json:"status"`
+	Stage     string   `json:"stage"`
+	Tags      []string `json:"tags"`
+	CreatedAt string   `json:"created_at"`
+}
+
+func (e *jsonEncoder) writeHeader() error {
+	_, err := io.WriteString(e.w, "[")
+	return err
+}
+
+func (e *jsonEncoder) writeEntry(vocab *models.Vocabulary) error {
+	data, err := json.Marshal(exportEntry{
+		Word:      vocab.Word,
+		Meaning:   vocab.Meaning,
+		Example:   vocab.Example,
+		Date:      vocab.Date.Format("2006-01-02"),
+		Status:    vocab.Status,
+		Stage:     vocab.Stage,
+		Tags:      tagNames(vocab.Tags),
+		CreatedAt: vocab.CreatedAt.Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+
+	separator := ",\n"
+	if e.count == 0 {
+		separator = "\n"
+	}
+	e.count++
+	if _, err := io.WriteString(e.w, separator); err != nil {
+		return err
+	}
+	_, err = e.w.Write(data)
+	return err
+}
+
+func (e *jsonEncoder) close() error {
+	_, err := io.WriteString(e.w, "\n]\n")
+	return err
+}
+
+// exportWriter sends everything written to it as chunks of an export stream
+type exportWriter struct {
+	stream proto.VocabularyService_ExportVocabulariesServer
+}
+
+func (w *exportWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&proto.ExportVocabulariesResponse{
+		Success: true,
+		Chunk:   p,
+	}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,130 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vocal-tracker/vocabulary-service/proto"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Tag filter modes
+const (
+	tagMatchAny = "any"
+	tagMatchAll = "all"
+)
+
+// filterVocabularies returns a scope that applies the user, date, search,
+// status, tag, deck and query filters of req, so listing and counting share
+// the same conditions
+func filterVocabularies(userID uint32, req *proto.GetVocabulariesRequest) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		db = db.Where("vocabularies.user_id = ?", userID)
+
+		// Filter by date if provided
+		if req.Date != "" {
+			if date, err := time.Parse("2006-01-02", req.Date); err == nil {
+				db = db.Where("vocabularies.date = ?", date)
+			}
+		}
+
+		// Search filter
+		if search := searchText(req); search != "" {
+			db = db.Where(searchCondition(search))
+		}
+
+		// Status filter
+		if req.Status != "" {
+			db = db.Where(statusCondition(req.Status))
+		}
+
+		// Tag filter, matching names case-insensitively
+		if names, _ := normalizeTagNames(req.Tags); len(names) > 0 {
+			lowered := make([]string, len(names))
+			for i, name := range names {
+				lowered[i] = strings.ToLower(name)
+			}
+			tagged := db.Session(&gorm.Session{NewDB: true}).
+				Table(vocabularyTagsTable+" vt").
+				Select("vt.vocabulary_id").
+				Joins("JOIN tags t ON t.id = vt.tag_id").
+				Where("LOWER(t.name) IN ?", lowered)
+			if req.TagMatch == tagMatchAll {
+				tagged = tagged.Group("vt.vocabulary_id").Having("COUNT(DISTINCT LOWER(t.name)) = ?", len(lowered))
+			}
+			db = db.Where("vocabularies.id IN (?)", tagged)
+		}
+
+		// Deck filter
+		db = deckScope(req.DeckId)(db)
+
+		// Filter query; validateVocabularyFilter rejects queries that do not parse
+		if query, err := compileQuery(req.Q, time.Now()); err == nil {
+			for _, condition := range query.conditions {
+				db = db.Where(condition)
+			}
+		}
+
+		return db
+	}
+}
+
+// validateVocabularyFilter checks the filters of req, returning a message for
+// the user if they are invalid
+func validateVocabularyFilter(userID uint32, req *proto.GetVocabulariesRequest) (string, error) {
+	if req.Date != "" {
+		if _, err := time.Parse("2006-01-02", req.Date); err != nil {
+			return "Invalid date format. Use YYYY-MM-DD", nil
+		}
+	}
+	if _, err := compileQuery(req.Q, time.Now()); err != nil {
+		return err.Error(), nil
+	}
+	if req.TagMatch != "" && req.TagMatch != tagMatchAny && req.TagMatch != tagMatchAll {
+		return "Invalid tag_match. Use any or all", nil
+	}
+	if _, err := normalizeTagNames(req.Tags); err != nil {
+		return err.Error(), nil
+	}
+	if req.Status != "" && !isValidStageName(req.Status) {
+		return fmt.Sprintf("Invalid status %q. Use a status or the name of one of your stages", req.Status), nil
+	}
+
+	if req.DeckId > 0 {
+		if _, err := findDeck(userID, req.DeckId); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return "Deck not found", nil
+			}
+			return "Database error", err
+		}
+	}
+	return "", nil
+}
+
+// statusCondition matches the entries with the given status or stage. A
+// stage named after a status always has that status, so the two agree.
+func statusCondition(name string) clause.Expr {
+	name = strings.ToLower(name)
+	return clause.Expr{SQL: "(vocabularies.status = ? OR vocabularies.stage = ?)", Vars: []interface{}{name, name}}
+}
+
+// isValidStageName reports whether name has the form of a stage name. Every
+// status does.
+func isValidStageName(name string) bool {
+	name = strings.ToLower(name)
+	return len(name) <= maxStageNameLength && stageNamePattern.MatchString(name)
+}
+
+// searchText returns the free text to search for: the search filter plus
+// any free text terms of the filter query
+func searchText(req *proto.GetVocabulariesRequest) string {
+	text := strings.TrimSpace(req.Search)
+	if query, err := compileQuery(req.Q, time.Now()); err == nil && query.text != "" {
+		text = strings.TrimSpace(text + " " + query.text)
+	}
+	return text
+}
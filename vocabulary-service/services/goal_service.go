@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/vocal-tracker/vocabulary-service/database"
+	"github.com/vocal-tracker/vocabulary-service/middleware"
+	"github.com/vocal-tracker/vocabulary-service/models"
+	"github.com/vocal-tracker/vocabulary-service/proto"
+
+	"gorm.io/gorm/clause"
+)
+
+const (
+	defaultDailyGoal = 10
+	maxDailyGoal     = 1000
+)
+
+// SetDailyGoal implements the SetDailyGoal RPC method
+func (s *VocabularyServiceImpl) SetDailyGoal(ctx context.Context, req *proto.SetDailyGoalRequest) (*proto.DailyGoalResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.DailyGoalResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.DailyGoalResponse{
+			Success: false,
+			Message: "Access denied: can only change your own daily goal",
+		}, nil
+	}
+
+	if req.DailyGoal < 1 || req.DailyGoal > maxDailyGoal {
+		return &proto.DailyGoalResponse{
+			Success: false,
+			Message: fmt.Sprintf("Daily goal must be between 1 and %d", maxDailyGoal),
+		}, nil
+	}
+
+	settings := models.UserSettings{
+		UserID:    uint(authenticatedUserID),
+		DailyGoal: int(req.DailyGoal),
+	}
+	if err := database.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"daily_goal", "updated_at"}),
+	}).Create(&settings).Error; err != nil {
+		return &proto.DailyGoalResponse{
+			Success: false,
+			Message: "Failed to update daily goal",
+		}, err
+	}
+
+	return &proto.DailyGoalResponse{
+		Success:   true,
+		Message:   "Daily goal updated successfully",
+		DailyGoal: int32(settings.DailyGoal),
+	}, nil
+}
+
+// dailyGoalFor returns the user's daily goal, or the default if none is set
+func dailyGoalFor(userID uint32) int {
+	var settings models.UserSettings
+	if err := database.DB.Where("user_id = ?", userID).First(&settings).Error; err != nil {
+		return defaultDailyGoal
+	}
+	return settings.DailyGoal
+}
+
+// activityDays returns the distinct days (YYYY-MM-DD) on which the user
+// added or reviewed a word, in ascending order. Days are in UTC, whatever
+// the time zone of the database session.
+func activityDays(userID uint32) ([]string, error) {
+	var days []string
+	err := database.DB.Raw(`SELECT to_char(day, 'YYYY-MM-DD') FROM (
+			SELECT DATE(created_at AT TIME ZONE 'UTC') AS day FROM vocabularies WHERE user_id = @user AND deleted_at IS NULL
+			UNION
+			SELECT DATE(reviewed_at AT TIME ZONE 'UTC') AS day FROM review_attempts WHERE user_id = @user
+				AND `+notTrashed("review_attempts.vocabulary_id")+`
+		) AS activity
+		ORDER BY day`,
+		map[string]interface{}{"user": userID}).Scan(&days).Error
+	return days, err
+}
+
+// computeStreaks returns the current and longest runs of consecutive days
+// in days (sorted YYYY-MM-DD). The current streak is still alive if the
+// last active day is today or yesterday, in UTC like the days.
+func computeStreaks(days []string, today time.Time) (current, longest int) {
+	var prev time.Time
+	run := 0
+	for _, day := range days {
+		d, err := time.Parse("2006-01-02", day)
+		if err != nil {
+			continue
+		}
+		if run > 0 && d.Equal(prev.AddDate(0, 0, 1)) {
+			run++
+		} else {
+			run = 1
+		}
+		longest = max(longest, run)
+		prev = d
+	}
+
+	todayDate, _ := time.Parse("2006-01-02", today.UTC().Format("2006-01-02"))
+	if run > 0 && (prev.Equal(todayDate) || prev.Equal(todayDate.AddDate(0, 0, -1))) {
+		current = run
+	}
+	return current, longest
+}
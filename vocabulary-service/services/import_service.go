@@ -0,0 +1,310 @@
+package services
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/vocal-tracker/vocabulary-service/database"
+	"github.com/vocal-tracker/vocabulary-service/middleware"
+	"github.com/vocal-tracker/vocabulary-service/models"
+	"github.com/vocal-tracker/vocabulary-service/proto"
+
+	"gorm.io/gorm"
+)
+
+// Import file formats
+const (
+	importFormatCSV = "csv"
+	importFormatTSV = "tsv"
+)
+
+// Outcome of each imported row
+const (
+	importCreated   = "created"
+	importDuplicate = "duplicate"
+	importMerged    = "merged"
+	importFailed    = "failed"
+)
+
+// maxImportRows is the number of data rows read from a single import file
+const maxImportRows = 5000
+
+// importColumns are the header names an import file can use, in any order
+var importColumns = []string{"word", "meaning", "example", "date", "status", "tags"}
+
+// ImportVocabularies implements the ImportVocabularies RPC method
+func (s *VocabularyServiceImpl) ImportVocabularies(stream proto.VocabularyService_ImportVocabulariesServer) error {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(stream.Context())
+	if err != nil {
+		return stream.SendAndClose(&proto.ImportVocabulariesResponse{
+			Success: false,
+			Message: "Authentication required",
+		})
+	}
+
+	// The settings come with the first message
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return stream.SendAndClose(&proto.ImportVocabulariesResponse{
+			Success: false,
+			Message: "Import file is empty",
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	// Verify the request is for the authenticated user
+	if first.UserId != authenticatedUserID {
+		return stream.SendAndClose(&proto.ImportVocabulariesResponse{
+			Success: false,
+			Message: "Access denied: can only import into your own account",
+		})
+	}
+
+	format := strings.ToLower(first.Format)
+	if format == "" {
+		format = importFormatCSV
+	}
+	if format != importFormatCSV && format != importFormatTSV {
+		return stream.SendAndClose(&proto.ImportVocabulariesResponse{
+			Success: false,
+			Message: "Invalid format. Use csv or tsv",
+		})
+	}
+
+	onDuplicate := first.OnDuplicate
+	if onDuplicate == "" {
+		onDuplicate = duplicateReject
+	}
+	if onDuplicate != duplicateReject && onDuplicate != duplicateMerge {
+		return stream.SendAndClose(&proto.ImportVocabulariesResponse{
+			Success: false,
+			Message: "Invalid on_duplicate. Use reject or merge",
+		})
+	}
+
+	machine, err := loadStageMachine(database.DB, uint(authenticatedUserID))
+	if err != nil {
+		return err
+	}
+
+	reader := csv.NewReader(&importReader{stream: stream, buf: first.Chunk})
+	reader.FieldsPerRecord = -1
+	if format == importFormatTSV {
+		// Spreadsheet TSV exports rarely quote fields
+		reader.Comma = '\t'
+		reader.LazyQuotes = true
+	}
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return stream.SendAndClose(&proto.ImportVocabulariesResponse{
+			Success: false,
+			Message: "Import file is empty",
+		})
+	}
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return stream.SendAndClose(&proto.ImportVocabulariesResponse{
+			Success: false,
+			Message: fmt.Sprintf("Invalid header: %v", parseErr.Err),
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	columns, err := mapImportColumns(header)
+	if err != nil {
+		return stream.SendAndClose(&proto.ImportVocabulariesResponse{
+			Success: false,
+			Message: err.Error(),
+		})
+	}
+
+	response := &proto.ImportVocabulariesResponse{Success: true}
+	today := time.Now().Format("2006-01-02")
+	truncated := false
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		var rowErr *csv.ParseError
+		if err != nil && !errors.As(err, &rowErr) {
+			return err
+		}
+		if rowErr == nil && isBlankRecord(record) {
+			continue
+		}
+		if len(response.Rows) == maxImportRows {
+			truncated = true
+			break
+		}
+
+		var result *proto.ImportRowResult
+		if rowErr != nil {
+			// A malformed row fails on its own; reading resumes after it
+			result = &proto.ImportRowResult{
+				Row:     int32(rowErr.StartLine),
+				Result:  importFailed,
+				Message: rowErr.Err.Error(),
+			}
+		} else {
+			line, _ := reader.FieldPos(0)
+			result = importRow(machine, authenticatedUserID, columns, record, line, today, onDuplicate)
+		}
+		response.Rows = append(response.Rows, result)
+
+		switch result.Result {
+		case importCreated:
+			response.Created++
+		case importDuplicate:
+			response.Duplicates++
+		case importMerged:
+			response.Merged++
+		default:
+			response.Failed++
+		}
+	}
+
+	response.Message = fmt.Sprintf("Imported %d rows: %d created, %d duplicates, %d merged, %d failed",
+		len(response.Rows), response.Created, response.Duplicates, response.Merged, response.Failed)
+	if truncated {
+		response.Message += fmt.Sprintf(". Only the first %d rows were read", maxImportRows)
+	}
+
+	return stream.SendAndClose(response)
+}
+
+// importRow validates one data row like CreateVocabulary would and stores it
+func importRow(machine *stageMachine, userID uint32, columns map[string]int, record []string, line int, today, onDuplicate string) *proto.ImportRowResult {
+	field := func(name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	result := &proto.ImportRowResult{
+		Row:  int32(line),
+		Word: field("word"),
+	}
+
+	// Rows without a date are added today
+	date := field("date")
+	if date == "" {
+		date = today
+	}
+
+	vocab, err := newVocabulary(machine, userID, field("word"), field("meaning"), field("example"), date, strings.ToLower(field("status")))
+	if err != nil {
+		result.Result = importFailed
+		result.Message = err.Error()
+		return result
+	}
+
+	names, err := normalizeTagNames(splitImportTags(field("tags")))
+	if err != nil {
+		result.Result = importFailed
+		result.Message = err.Error()
+		return result
+	}
+
+	// Each row is stored on its own, so one bad row does not undo the rest
+	var existing *models.Vocabulary
+	if err := database.DB.Transaction(func(tx *gorm.DB) error {
+		var err error
+		existing, err = createVocabulary(tx, &vocab, names, onDuplicate)
+		return err
+	}); err != nil {
+		result.Result = importFailed
+		result.Message = "Failed to create vocabulary"
+		return result
+	}
+
+	switch {
+	case existing == nil:
+		result.Result = importCreated
+		result.VocabularyId = uint32(vocab.ID)
+	case onDuplicate == duplicateMerge:
+		result.Result = importMerged
+		result.Message = "Merged into the existing entry"
+		result.VocabularyId = uint32(existing.ID)
+	default:
+		result.Result = importDuplicate
+		result.Message = fmt.Sprintf("Vocabulary %q already exists", existing.Word)
+		result.VocabularyId = uint32(existing.ID)
+	}
+	return result
+}
+
+// mapImportColumns returns the position of each known column in the header.
+// Unknown columns are ignored, so spreadsheets can keep their own notes.
+func mapImportColumns(header []string) (map[string]int, error) {
+	columns := make(map[string]int)
+	for i, name := range header {
+		if i == 0 {
+			// Spreadsheet exports often start with a byte order mark
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !slices.Contains(importColumns, name) {
+			continue
+		}
+		if _, ok := columns[name]; ok {
+			return nil, fmt.Errorf("Column %q appears more than once in the header", name)
+		}
+		columns[name] = i
+	}
+
+	_, hasWord := columns["word"]
+	_, hasMeaning := columns["meaning"]
+	if !hasWord || !hasMeaning {
+		return nil, fmt.Errorf("The header must name the word and meaning columns. Known columns: %s", strings.Join(importColumns, ", "))
+	}
+	return columns, nil
+}
+
+// splitImportTags splits a tags cell on commas or semicolons
+func splitImportTags(cell string) []string {
+	return strings.FieldsFunc(cell, func(r rune) bool {
+		return r == ',' || r == ';'
+	})
+}
+
+// isBlankRecord reports whether every field of a row is empty
+func isBlankRecord(record []string) bool {
+	for _, field := range record {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// importReader reads the chunks of an import stream as one file
+type importReader struct {
+	stream proto.VocabularyService_ImportVocabulariesServer
+	buf    []byte
+}
+
+func (r *importReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = req.Chunk
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
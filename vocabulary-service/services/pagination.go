@@ -0,0 +1,201 @@
+package services
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/vocal-tracker/vocabulary-service/models"
+	"github.com/vocal-tracker/vocabulary-service/proto"
+
+	"gorm.io/gorm/clause"
+)
+
+// Sort orders
+const (
+	sortAsc  = "asc"
+	sortDesc = "desc"
+)
+
+// sortByRelevance ranks search results; it can only be paged with offset
+const sortByRelevance = "relevance"
+
+// neverDue stands in for the next review of entries never reviewed, so they
+// sort after every scheduled entry
+var neverDue = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+
+// sortField is a column vocabularies can be sorted and paged by
+type sortField struct {
+	expression   string
+	defaultOrder string
+	// key returns the entry's value of the expression
+	key func(vocab *models.Vocabulary) interface{}
+	// isTime tells page tokens to decode the key as a time
+	isTime bool
+}
+
+var sortFields = map[string]sortField{
+	"created_at": {
+		expression:   "vocabularies.created_at",
+		defaultOrder: sortDesc,
+		key:          func(vocab *models.Vocabulary) interface{} { return vocab.CreatedAt },
+		isTime:       true,
+	},
+	"updated_at": {
+		expression:   "vocabularies.updated_at",
+		defaultOrder: sortDesc,
+		key:          func(vocab *models.Vocabulary) interface{} { return vocab.UpdatedAt },
+		isTime:       true,
+	},
+	"date": {
+		expression:   "vocabularies.date",
+		defaultOrder: sortDesc,
+		key:          func(vocab *models.Vocabulary) interface{} { return vocab.Date },
+		isTime:       true,
+	},
+	// Words sort by their normalised form, so case and accents do not split them
+	"word": {
+		expression:   "vocabularies.normalized_word",
+		defaultOrder: sortAsc,
+		key:          func(vocab *models.Vocabulary) interface{} { return vocab.NormalizedWord },
+	},
+	"status": {
+		expression:   "vocabularies.status",
+		defaultOrder: sortAsc,
+		key:          func(vocab *models.Vocabulary) interface{} { return vocab.Status },
+	},
+	"next_review_at": {
+		expression:   "COALESCE(vocabularies.next_review_at, '9999-12-31 00:00:00+00')",
+		defaultOrder: sortAsc,
+		key: func(vocab *models.Vocabulary) interface{} {
+			if vocab.NextReviewAt == nil {
+				return neverDue
+			}
+			return *vocab.NextReviewAt
+		},
+		isTime: true,
+	},
+}
+
+// vocabularySort is the order of a vocabulary list. Entries with the same
+// sort key are ordered by ID, so every entry has a unique position and
+// pages neither skip nor repeat entries when others are added or removed.
+type vocabularySort struct {
+	by    string
+	order string
+}
+
+// pageToken is the decoded form of a page token: where the previous page
+// ended, and the order it was in
+type pageToken struct {
+	SortBy string          `json:"s"`
+	Order  string          `json:"o"`
+	Key    json.RawMessage `json:"k"`
+	ID     uint            `json:"id"`
+}
+
+// parseVocabularySort returns the sort requested by req, returning a message
+// for the user if it is invalid. searching tells whether req has a search,
+// which is then ranked by relevance unless another sort is asked for.
+func parseVocabularySort(req *proto.GetVocabulariesRequest, searching bool) (*vocabularySort, string) {
+	sort := &vocabularySort{by: req.SortBy, order: req.SortOrder}
+	if sort.by == "" {
+		sort.by = "created_at"
+		if searching {
+			sort.by = sortByRelevance
+		}
+	}
+
+	if sort.by == sortByRelevance {
+		if !searching {
+			return nil, "Sorting by relevance needs a search"
+		}
+		if req.PageToken != "" {
+			return nil, "page_token cannot be used when sorting by relevance; use offset"
+		}
+		return sort, ""
+	}
+
+	field, ok := sortFields[sort.by]
+	if !ok {
+		return nil, "Invalid sort_by. Use created_at, updated_at, date, word, status, next_review_at or relevance"
+	}
+	if sort.order == "" {
+		sort.order = field.defaultOrder
+	}
+	if sort.order != sortAsc && sort.order != sortDesc {
+		return nil, "Invalid sort_order. Use asc or desc"
+	}
+	return sort, ""
+}
+
+// orderBy returns the ORDER BY clause of the sort, given the search when
+// sorting by relevance
+func (s *vocabularySort) orderBy(search string) clause.OrderBy {
+	if s.by == sortByRelevance {
+		return searchRank(search)
+	}
+	desc := s.order == sortDesc
+	return clause.OrderBy{Columns: []clause.OrderByColumn{
+		{Column: clause.Column{Name: sortFields[s.by].expression, Raw: true}, Desc: desc},
+		{Column: clause.Column{Name: "vocabularies.id", Raw: true}, Desc: desc},
+	}}
+}
+
+// after returns the condition selecting the entries after a page token, or
+// a message for the user if the token is invalid or from a different sort
+func (s *vocabularySort) after(token string) (clause.Expression, string) {
+	const invalidToken = "Invalid page_token"
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, invalidToken
+	}
+	var decoded pageToken
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, invalidToken
+	}
+	if decoded.SortBy != s.by || decoded.Order != s.order {
+		return nil, "page_token is from a different sort; request the first page again"
+	}
+
+	field := sortFields[s.by]
+	var key interface{}
+	if field.isTime {
+		var t time.Time
+		if err := json.Unmarshal(decoded.Key, &t); err != nil {
+			return nil, invalidToken
+		}
+		key = t
+	} else {
+		var str string
+		if err := json.Unmarshal(decoded.Key, &str); err != nil {
+			return nil, invalidToken
+		}
+		key = str
+	}
+
+	// Row comparison covers ties on the key, which are ordered by ID
+	op := ">"
+	if s.order == sortDesc {
+		op = "<"
+	}
+	return clause.Expr{
+		SQL:  fmt.Sprintf("(%s, vocabularies.id) %s (?, ?)", field.expression, op),
+		Vars: []interface{}{key, decoded.ID},
+	}, ""
+}
+
+// token returns the page token for the entries after vocab
+func (s *vocabularySort) token(vocab *models.Vocabulary) (string, error) {
+	key, err := json.Marshal(sortFields[s.by].key(vocab))
+	if err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(pageToken{SortBy: s.by, Order: s.order, Key: key, ID: vocab.ID})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
@@ -0,0 +1,225 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/vocal-tracker/vocabulary-service/database"
+	"github.com/vocal-tracker/vocabulary-service/middleware"
+	"github.com/vocal-tracker/vocabulary-service/proto"
+)
+
+// Intervals of a progress timeline
+const (
+	progressDay  = "day"
+	progressWeek = "week"
+)
+
+const (
+	// maxProgressPoints is the most days or weeks a timeline can cover
+	maxProgressPoints = 366
+	// defaultProgressDays and defaultProgressWeeks are the lengths of a
+	// timeline without date_from
+	defaultProgressDays  = 30
+	defaultProgressWeeks = 12
+)
+
+// progressEvent is a change to the status of an entry: a transition to
+// status, or the entry being moved to the trash when status is empty
+type progressEvent struct {
+	VocabularyID uint
+	Status       string
+	At           time.Time
+	DeletedAt    *time.Time
+}
+
+// GetProgressTimeline implements the GetProgressTimeline RPC method. It
+// replays the user's status transitions, so each point has the status every
+// entry had at the end of its day or week, not the status it has now. An
+// entry counts from its first transition until it was moved to the trash.
+func (s *VocabularyServiceImpl) GetProgressTimeline(ctx context.Context, req *proto.GetProgressTimelineRequest) (*proto.GetProgressTimelineResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.GetProgressTimelineResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.GetProgressTimelineResponse{
+			Success: false,
+			Message: "Access denied: can only access your own statistics",
+		}, nil
+	}
+
+	interval := strings.ToLower(strings.TrimSpace(req.Interval))
+	if interval == "" {
+		interval = progressDay
+	}
+	if interval != progressDay && interval != progressWeek {
+		return &proto.GetProgressTimelineResponse{
+			Success: false,
+			Message: "Invalid interval. Use day or week",
+		}, nil
+	}
+
+	starts, err := progressPeriods(req.DateFrom, req.DateTo, interval, time.Now())
+	if err != nil {
+		return &proto.GetProgressTimelineResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	from, to := starts[0], periodEnd(starts[len(starts)-1], interval)
+
+	// The status of each entry at the start of the timeline, then every
+	// transition during it. Transitions of entries already in the trash,
+	// which changes of stages still make, are left out.
+	var before, during []progressEvent
+	if err := database.DB.Raw(`SELECT DISTINCT ON (t.vocabulary_id) t.vocabulary_id, t.to_status AS status,
+			t.created_at AS at, v.deleted_at
+		FROM status_transitions t
+		JOIN vocabularies v ON v.id = t.vocabulary_id
+		WHERE t.user_id = @user AND t.created_at < @from AND (v.deleted_at IS NULL OR v.deleted_at >= @from)
+		ORDER BY t.vocabulary_id, t.created_at DESC, t.id DESC`,
+		map[string]interface{}{"user": authenticatedUserID, "from": from}).Scan(&before).Error; err != nil {
+		return &proto.GetProgressTimelineResponse{
+			Success: false,
+			Message: "Failed to fetch transitions",
+		}, err
+	}
+	if err := database.DB.Raw(`SELECT t.vocabulary_id, t.to_status AS status, t.created_at AS at, v.deleted_at
+		FROM status_transitions t
+		JOIN vocabularies v ON v.id = t.vocabulary_id
+		WHERE t.user_id = @user AND t.created_at >= @from AND t.created_at < @to
+			AND (v.deleted_at IS NULL OR v.deleted_at > t.created_at)
+		ORDER BY t.created_at, t.id`,
+		map[string]interface{}{"user": authenticatedUserID, "from": from, "to": to}).Scan(&during).Error; err != nil {
+		return &proto.GetProgressTimelineResponse{
+			Success: false,
+			Message: "Failed to fetch transitions",
+		}, err
+	}
+
+	return &proto.GetProgressTimelineResponse{
+		Success:  true,
+		Message:  "Progress timeline retrieved successfully",
+		Interval: interval,
+		Points:   progressTimeline(append(before, during...), starts, interval),
+	}, nil
+}
+
+// progressPeriods returns the first day of each day or week of a timeline
+// from dateFrom to dateTo, in UTC. Weeks start on Monday. Days after today
+// are left out.
+func progressPeriods(dateFrom, dateTo, interval string, now time.Time) ([]time.Time, error) {
+	today := now.UTC().Truncate(24 * time.Hour)
+	to := today
+	if dateTo != "" {
+		parsed, err := time.Parse("2006-01-02", dateTo)
+		if err != nil {
+			return nil, errors.New("Invalid date_to format. Use YYYY-MM-DD")
+		}
+		if parsed.Before(today) {
+			to = parsed
+		}
+	}
+	if interval == progressWeek {
+		to = weekStart(to)
+	}
+
+	var from time.Time
+	switch {
+	case dateFrom != "":
+		parsed, err := time.Parse("2006-01-02", dateFrom)
+		if err != nil {
+			return nil, errors.New("Invalid date_from format. Use YYYY-MM-DD")
+		}
+		from = parsed
+		if interval == progressWeek {
+			from = weekStart(from)
+		}
+	case interval == progressWeek:
+		from = to.AddDate(0, 0, -7*(defaultProgressWeeks-1))
+	default:
+		from = to.AddDate(0, 0, -(defaultProgressDays - 1))
+	}
+	if from.After(to) {
+		return nil, errors.New("date_from must not be after date_to")
+	}
+
+	var starts []time.Time
+	for start := from; !start.After(to); start = periodEnd(start, interval) {
+		if len(starts) == maxProgressPoints {
+			return nil, fmt.Errorf("A timeline can cover at most %d days or weeks", maxProgressPoints)
+		}
+		starts = append(starts, start)
+	}
+	return starts, nil
+}
+
+// progressTimeline replays events, sorted by time, and counts the entries in
+// each status at the end of each period starting at starts
+func progressTimeline(events []progressEvent, starts []time.Time, interval string) []*proto.ProgressPoint {
+	// Moves to the trash are events too, at the time of the move
+	end := periodEnd(starts[len(starts)-1], interval)
+	seen := make(map[uint]bool)
+	for _, event := range events {
+		if event.DeletedAt != nil && !seen[event.VocabularyID] && event.DeletedAt.Before(end) {
+			events = append(events, progressEvent{VocabularyID: event.VocabularyID, At: *event.DeletedAt})
+		}
+		seen[event.VocabularyID] = true
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].At.Before(events[j].At) })
+
+	current := make(map[uint]string)
+	counts := make(map[string]int32, len(statuses))
+	points := make([]*proto.ProgressPoint, len(starts))
+	next := 0
+	for i, start := range starts {
+		end := periodEnd(start, interval)
+		for ; next < len(events) && events[next].At.Before(end); next++ {
+			event := events[next]
+			if status, ok := current[event.VocabularyID]; ok {
+				counts[status]--
+				delete(current, event.VocabularyID)
+			}
+			if event.Status != "" {
+				current[event.VocabularyID] = event.Status
+				counts[event.Status]++
+			}
+		}
+
+		statusCounts := make(map[string]int32, len(statuses))
+		for _, status := range statuses {
+			statusCounts[status] = counts[status]
+		}
+		points[i] = &proto.ProgressPoint{
+			Date:         start.Format("2006-01-02"),
+			StatusCounts: statusCounts,
+			Total:        int32(len(current)),
+		}
+	}
+	return points
+}
+
+// periodEnd returns the start of the day or week after the one starting at
+// start
+func periodEnd(start time.Time, interval string) time.Time {
+	if interval == progressWeek {
+		return start.AddDate(0, 0, 7)
+	}
+	return start.AddDate(0, 0, 1)
+}
+
+// weekStart returns the Monday of the week of day
+func weekStart(day time.Time) time.Time {
+	return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+}
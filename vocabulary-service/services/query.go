@@ -0,0 +1,348 @@
+package services
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"gorm.io/gorm/clause"
+)
+
+// The filter query language of GetVocabulariesRequest.q. A query is a list of
+// terms separated by spaces, all of which must match:
+//
+//	status:mastered tag:gre date>=2024-01-01 -example:empty word:abs*
+//
+// A term is field:value (field=value is the same), field>value,
+// field>=value, field<value or field<=value. A leading - negates a term.
+// Values with spaces go in double quotes. In an unquoted value, * matches
+// any characters and the value empty matches an empty field. Terms without a
+// field are free text, searched the same way as GetVocabulariesRequest.search.
+//
+// Terms compile to SQL conditions with every value passed as a bind
+// variable, and fields map to a fixed set of columns.
+
+// queryEmpty is the value that matches an empty field
+const queryEmpty = "empty"
+
+// queryTerm is a single term of a filter query
+type queryTerm struct {
+	pos      int // Position of the term, from 1
+	fieldPos int
+	valuePos int
+	negate   bool
+	field    string // Empty for free text
+	op       string // ":", ">", ">=", "<" or "<="
+	value    string
+	quoted   bool
+}
+
+// isEmpty reports whether the term asks for an empty field
+func (t queryTerm) isEmpty() bool {
+	return !t.quoted && strings.EqualFold(t.value, queryEmpty)
+}
+
+// queryError is a problem with a filter query at a position, from 1
+type queryError struct {
+	pos int
+	msg string
+}
+
+func (e *queryError) Error() string {
+	return fmt.Sprintf("Invalid query at position %d: %s", e.pos, e.msg)
+}
+
+// vocabularyQuery is a compiled filter query
+type vocabularyQuery struct {
+	conditions []clause.Expression
+	text       string // Free text terms, to search for
+}
+
+// queryField compiles a term on one field
+type queryField func(term queryTerm, today time.Time) (clause.Expression, *queryError)
+
+var queryFields = map[string]queryField{
+	"word":     textField("vocabularies.word"),
+	"meaning":  textField("vocabularies.meaning"),
+	"example":  textField("vocabularies.example"),
+	"status":   statusField,
+	"tag":      tagField,
+	"deck":     deckField,
+	"date":     dateField("vocabularies.date"),
+	"created":  dateField("vocabularies.created_at"),
+	"updated":  dateField("vocabularies.updated_at"),
+	"reviewed": dateField("vocabularies.last_reviewed_at"),
+	"due":      dateField("vocabularies.next_review_at"),
+}
+
+// relativeDatePattern matches dates relative to today, such as -7d or +2w
+var relativeDatePattern = regexp.MustCompile(`^([+-])(\d{1,4})([dwmy])$`)
+
+// compileQuery parses a filter query into SQL conditions. Relative dates
+// are counted from now.
+func compileQuery(q string, now time.Time) (*vocabularyQuery, *queryError) {
+	terms, err := parseQuery(q)
+	if err != nil {
+		return nil, err
+	}
+
+	now = now.UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	query := &vocabularyQuery{}
+	var text []string
+	for _, term := range terms {
+		var condition clause.Expression
+		if term.field == "" {
+			if !term.negate {
+				if term.quoted {
+					text = append(text, `"`+term.value+`"`)
+				} else {
+					text = append(text, term.value)
+				}
+				continue
+			}
+			pattern := "%" + likeEscaper.Replace(strings.ToLower(term.value)) + "%"
+			condition = clause.Expr{
+				SQL: `(LOWER(vocabularies.word) LIKE ? OR LOWER(vocabularies.meaning) LIKE ?
+					OR LOWER(COALESCE(vocabularies.example, '')) LIKE ?)`,
+				Vars: []interface{}{pattern, pattern, pattern},
+			}
+		} else {
+			compile, ok := queryFields[term.field]
+			if !ok {
+				return nil, &queryError{pos: term.fieldPos, msg: fmt.Sprintf("unknown field %q", term.field)}
+			}
+			if condition, err = compile(term, today); err != nil {
+				return nil, err
+			}
+		}
+
+		// A NULL column fails the condition, so its negation must match it
+		if term.negate {
+			condition = clause.Expr{SQL: "NOT COALESCE(?, false)", Vars: []interface{}{condition}}
+		}
+		query.conditions = append(query.conditions, condition)
+	}
+	query.text = strings.Join(text, " ")
+	return query, nil
+}
+
+// parseQuery splits a filter query into terms
+func parseQuery(q string) ([]queryTerm, *queryError) {
+	runes := []rune(q)
+	var terms []queryTerm
+	i := 0
+	for {
+		for i < len(runes) && unicode.IsSpace(runes[i]) {
+			i++
+		}
+		if i == len(runes) {
+			return terms, nil
+		}
+
+		term := queryTerm{pos: i + 1}
+		if runes[i] == '-' && i+1 < len(runes) && !unicode.IsSpace(runes[i+1]) {
+			term.negate = true
+			i++
+		}
+
+		// A field is a name followed by an operator
+		start := i
+		for i < len(runes) && (unicode.IsLetter(runes[i]) || runes[i] == '_') {
+			i++
+		}
+		if i > start && i < len(runes) && strings.ContainsRune(":=<>", runes[i]) {
+			term.field = strings.ToLower(string(runes[start:i]))
+			term.fieldPos = start + 1
+			op := i
+			i++
+			if (runes[op] == '<' || runes[op] == '>') && i < len(runes) && runes[i] == '=' {
+				i++
+			}
+			term.op = string(runes[op:i])
+			if term.op == "=" {
+				term.op = ":"
+			}
+		} else {
+			i = start
+		}
+
+		term.valuePos = i + 1
+		value, quoted, next, err := readQueryValue(runes, i)
+		if err != nil {
+			return nil, err
+		}
+		if term.field != "" && value == "" && !quoted {
+			return nil, &queryError{pos: term.valuePos, msg: fmt.Sprintf("missing value after %s%s", term.field, term.op)}
+		}
+		term.value, term.quoted, i = value, quoted, next
+		terms = append(terms, term)
+	}
+}
+
+// readQueryValue reads a quoted or unquoted value starting at runes[i],
+// returning the index after it
+func readQueryValue(runes []rune, i int) (string, bool, int, *queryError) {
+	if i == len(runes) || runes[i] != '"' {
+		start := i
+		for i < len(runes) && !unicode.IsSpace(runes[i]) {
+			i++
+		}
+		return string(runes[start:i]), false, i, nil
+	}
+
+	var value strings.Builder
+	for j := i + 1; j < len(runes); j++ {
+		switch {
+		case runes[j] == '\\' && j+1 < len(runes):
+			j++
+			value.WriteRune(runes[j])
+		case runes[j] == '"':
+			if j+1 < len(runes) && !unicode.IsSpace(runes[j+1]) {
+				return "", false, 0, &queryError{pos: j + 2, msg: "expected a space after the closing quote"}
+			}
+			return value.String(), true, j + 1, nil
+		default:
+			value.WriteRune(runes[j])
+		}
+	}
+	return "", false, 0, &queryError{pos: i + 1, msg: "unterminated quote"}
+}
+
+// textMatch returns the operator and argument comparing a lower-cased column
+// with the term's value: equality, or LIKE when the value has wildcards
+func textMatch(term queryTerm) (string, string) {
+	value := strings.ToLower(term.value)
+	if term.quoted || !strings.Contains(value, "*") {
+		return "=", value
+	}
+	return "LIKE", strings.ReplaceAll(likeEscaper.Replace(value), "*", "%")
+}
+
+// requireMatchOperator rejects comparisons on fields that can only be matched
+func requireMatchOperator(term queryTerm) *queryError {
+	if term.op != ":" {
+		return &queryError{pos: term.valuePos - len(term.op), msg: fmt.Sprintf("%s does not support %s, use %s:", term.field, term.op, term.field)}
+	}
+	return nil
+}
+
+func textField(column string) queryField {
+	return func(term queryTerm, _ time.Time) (clause.Expression, *queryError) {
+		if err := requireMatchOperator(term); err != nil {
+			return nil, err
+		}
+		if term.isEmpty() {
+			return clause.Expr{SQL: "COALESCE(" + column + ", '') = ''"}, nil
+		}
+		op, arg := textMatch(term)
+		return clause.Expr{SQL: "LOWER(" + column + ") " + op + " ?", Vars: []interface{}{arg}}, nil
+	}
+}
+
+func statusField(term queryTerm, _ time.Time) (clause.Expression, *queryError) {
+	if err := requireMatchOperator(term); err != nil {
+		return nil, err
+	}
+	if !isValidStageName(term.value) {
+		return nil, &queryError{pos: term.valuePos, msg: fmt.Sprintf("invalid status %q, use a status or the name of one of your stages", term.value)}
+	}
+	return statusCondition(term.value), nil
+}
+
+func tagField(term queryTerm, _ time.Time) (clause.Expression, *queryError) {
+	if err := requireMatchOperator(term); err != nil {
+		return nil, err
+	}
+	if term.isEmpty() {
+		return clause.Expr{SQL: "NOT EXISTS (SELECT 1 FROM " + vocabularyTagsTable + " vt WHERE vt.vocabulary_id = vocabularies.id)"}, nil
+	}
+	op, arg := textMatch(term)
+	return clause.Expr{
+		SQL: "EXISTS (SELECT 1 FROM " + vocabularyTagsTable + " vt JOIN tags t ON t.id = vt.tag_id " +
+			"WHERE vt.vocabulary_id = vocabularies.id AND LOWER(t.name) " + op + " ?)",
+		Vars: []interface{}{arg},
+	}, nil
+}
+
+func deckField(term queryTerm, _ time.Time) (clause.Expression, *queryError) {
+	if err := requireMatchOperator(term); err != nil {
+		return nil, err
+	}
+	if term.isEmpty() {
+		return clause.Expr{SQL: "NOT EXISTS (SELECT 1 FROM " + vocabularyDecksTable + " vd WHERE vd.vocabulary_id = vocabularies.id)"}, nil
+	}
+	op, arg := textMatch(term)
+	return clause.Expr{
+		SQL: "EXISTS (SELECT 1 FROM " + vocabularyDecksTable + " vd JOIN decks d ON d.id = vd.deck_id " +
+			"WHERE vd.vocabulary_id = vocabularies.id AND LOWER(d.name) " + op + " ?)",
+		Vars: []interface{}{arg},
+	}, nil
+}
+
+// dateField compares a date or timestamp column by whole days: created:2024-01-01
+// matches anything on that day and created>2024-01-01 anything from the next day
+func dateField(column string) queryField {
+	return func(term queryTerm, today time.Time) (clause.Expression, *queryError) {
+		if term.isEmpty() {
+			if err := requireMatchOperator(term); err != nil {
+				return nil, err
+			}
+			return clause.Expr{SQL: column + " IS NULL"}, nil
+		}
+
+		day, ok := parseQueryDate(term.value, today)
+		if !ok {
+			return nil, &queryError{pos: term.valuePos, msg: fmt.Sprintf("invalid date %q, use YYYY-MM-DD, today, yesterday or a relative date such as -7d", term.value)}
+		}
+		next := day.AddDate(0, 0, 1)
+
+		switch term.op {
+		case ">":
+			return clause.Expr{SQL: column + " >= ?", Vars: []interface{}{next}}, nil
+		case ">=":
+			return clause.Expr{SQL: column + " >= ?", Vars: []interface{}{day}}, nil
+		case "<":
+			return clause.Expr{SQL: column + " < ?", Vars: []interface{}{day}}, nil
+		case "<=":
+			return clause.Expr{SQL: column + " < ?", Vars: []interface{}{next}}, nil
+		default:
+			return clause.Expr{SQL: "(" + column + " >= ? AND " + column + " < ?)", Vars: []interface{}{day, next}}, nil
+		}
+	}
+}
+
+// parseQueryDate parses YYYY-MM-DD, today, yesterday, or a number of days,
+// weeks, months or years before (-) or after (+) today
+func parseQueryDate(value string, today time.Time) (time.Time, bool) {
+	switch strings.ToLower(value) {
+	case "today":
+		return today, true
+	case "yesterday":
+		return today.AddDate(0, 0, -1), true
+	}
+
+	if match := relativeDatePattern.FindStringSubmatch(strings.ToLower(value)); match != nil {
+		n, _ := strconv.Atoi(match[2])
+		if match[1] == "-" {
+			n = -n
+		}
+		switch match[3] {
+		case "d":
+			return today.AddDate(0, 0, n), true
+		case "w":
+			return today.AddDate(0, 0, 7*n), true
+		case "m":
+			return today.AddDate(0, n, 0), true
+		default:
+			return today.AddDate(n, 0, 0), true
+		}
+	}
+
+	date, err := time.Parse("2006-01-02", value)
+	return date, err == nil
+}
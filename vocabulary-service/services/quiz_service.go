@@ -0,0 +1,411 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/vocal-tracker/vocabulary-service/database"
+	"github.com/vocal-tracker/vocabulary-service/middleware"
+	"github.com/vocal-tracker/vocabulary-service/models"
+	"github.com/vocal-tracker/vocabulary-service/proto"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Quiz directions
+const (
+	quizWordToMeaning = "word_to_meaning"
+	quizMeaningToWord = "meaning_to_word"
+)
+
+const (
+	defaultQuizQuestions = 10
+	maxQuizQuestions     = 50
+	defaultQuizChoices   = 4
+	maxQuizChoices       = 6
+	// quizDistractorPool is the number of entries sampled for distractors
+	quizDistractorPool = 200
+	// quizSessionTTL is how long a generated quiz can be submitted
+	quizSessionTTL = 24 * time.Hour
+)
+
+// errQuizRejected rolls back a quiz submission that cannot be graded
+var errQuizRejected = errors.New("quiz rejected")
+
+// GenerateQuiz implements the GenerateQuiz RPC method
+func (s *VocabularyServiceImpl) GenerateQuiz(ctx context.Context, req *proto.GenerateQuizRequest) (*proto.GenerateQuizResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.GenerateQuizResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.GenerateQuizResponse{
+			Success: false,
+			Message: "Access denied: can only quiz your own vocabularies",
+		}, nil
+	}
+
+	direction := req.Direction
+	if direction == "" {
+		direction = quizWordToMeaning
+	}
+	if direction != quizWordToMeaning && direction != quizMeaningToWord {
+		return &proto.GenerateQuizResponse{
+			Success: false,
+			Message: "Invalid direction. Use word_to_meaning or meaning_to_word",
+		}, nil
+	}
+
+	questionCount := int(req.QuestionCount)
+	if questionCount <= 0 {
+		questionCount = defaultQuizQuestions
+	}
+	if questionCount > maxQuizQuestions {
+		questionCount = maxQuizQuestions
+	}
+
+	choiceCount := int(req.ChoiceCount)
+	if choiceCount <= 0 {
+		choiceCount = defaultQuizChoices
+	}
+	if choiceCount < 2 || choiceCount > maxQuizChoices {
+		return &proto.GenerateQuizResponse{
+			Success: false,
+			Message: fmt.Sprintf("Choice count must be between 2 and %d", maxQuizChoices),
+		}, nil
+	}
+
+	query := database.DB.Where("user_id = ?", authenticatedUserID)
+	if req.Status != "" {
+		query = query.Where(statusCondition(req.Status))
+	}
+	if req.DateFrom != "" {
+		dateFrom, err := time.Parse("2006-01-02", req.DateFrom)
+		if err != nil {
+			return &proto.GenerateQuizResponse{
+				Success: false,
+				Message: "Invalid date_from format. Use YYYY-MM-DD",
+			}, nil
+		}
+		query = query.Where("date >= ?", dateFrom)
+	}
+	if req.DateTo != "" {
+		dateTo, err := time.Parse("2006-01-02", req.DateTo)
+		if err != nil {
+			return &proto.GenerateQuizResponse{
+				Success: false,
+				Message: "Invalid date_to format. Use YYYY-MM-DD",
+			}, nil
+		}
+		query = query.Where("date <= ?", dateTo)
+	}
+
+	var entries []models.Vocabulary
+	if err := query.Order("RANDOM()").Limit(questionCount).Find(&entries).Error; err != nil {
+		return &proto.GenerateQuizResponse{
+			Success: false,
+			Message: "Failed to fetch vocabularies",
+		}, err
+	}
+	if len(entries) == 0 {
+		return &proto.GenerateQuizResponse{
+			Success: false,
+			Message: "No vocabularies match the quiz filters",
+		}, nil
+	}
+
+	// Distractors come from any of the user's entries, not only the filtered ones
+	var pool []models.Vocabulary
+	if err := database.DB.Select("id", "word", "meaning").
+		Where("user_id = ?", authenticatedUserID).
+		Order("RANDOM()").
+		Limit(quizDistractorPool).
+		Find(&pool).Error; err != nil {
+		return &proto.GenerateQuizResponse{
+			Success: false,
+			Message: "Failed to fetch vocabularies",
+		}, err
+	}
+
+	questions := make([]*proto.QuizQuestion, 0, len(entries))
+	for _, entry := range entries {
+		prompt, answer := quizPromptAndAnswer(&entry, direction)
+
+		options := []string{answer}
+		seen := map[string]bool{normalizeAnswer(answer): true}
+		for _, candidate := range pool {
+			if len(options) == choiceCount {
+				break
+			}
+			_, distractor := quizPromptAndAnswer(&candidate, direction)
+			key := normalizeAnswer(distractor)
+			if candidate.ID == entry.ID || key == "" || seen[key] {
+				continue
+			}
+			seen[key] = true
+			options = append(options, distractor)
+		}
+		if len(options) < 2 {
+			return &proto.GenerateQuizResponse{
+				Success: false,
+				Message: "Not enough distinct vocabularies to build answer choices",
+			}, nil
+		}
+
+		rand.Shuffle(len(options), func(i, j int) {
+			options[i], options[j] = options[j], options[i]
+		})
+
+		questions = append(questions, &proto.QuizQuestion{
+			VocabularyId: uint32(entry.ID),
+			Prompt:       prompt,
+			Options:      options,
+		})
+	}
+
+	// Record the quiz, so that only its questions can be graded; expired
+	// quizzes of the user are dropped at the same time
+	session := models.QuizSession{
+		UserID:    uint(authenticatedUserID),
+		Direction: direction,
+		Questions: make([]models.QuizQuestion, len(entries)),
+	}
+	for i, entry := range entries {
+		session.Questions[i] = models.QuizQuestion{VocabularyID: entry.ID}
+	}
+	if err := database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ? AND created_at < ?", authenticatedUserID, time.Now().Add(-quizSessionTTL)).
+			Delete(&models.QuizSession{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&session).Error
+	}); err != nil {
+		return &proto.GenerateQuizResponse{
+			Success: false,
+			Message: "Failed to save quiz",
+		}, err
+	}
+
+	return &proto.GenerateQuizResponse{
+		Success:   true,
+		Message:   "Quiz generated successfully",
+		Direction: direction,
+		Questions: questions,
+		QuizId:    uint32(session.ID),
+	}, nil
+}
+
+// SubmitQuiz implements the SubmitQuiz RPC method. Answers are graded
+// against the quiz generated by GenerateQuiz, in its direction, and only for
+// its questions that have not been answered yet.
+func (s *VocabularyServiceImpl) SubmitQuiz(ctx context.Context, req *proto.SubmitQuizRequest) (*proto.SubmitQuizResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.SubmitQuizResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.SubmitQuizResponse{
+			Success: false,
+			Message: "Access denied: can only submit quizzes for your own vocabularies",
+		}, nil
+	}
+
+	if req.QuizId == 0 {
+		return &proto.SubmitQuizResponse{
+			Success: false,
+			Message: "quiz_id is required",
+		}, nil
+	}
+
+	var session models.QuizSession
+	if err := database.DB.Where("id = ? AND user_id = ?", req.QuizId, authenticatedUserID).First(&session).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &proto.SubmitQuizResponse{
+				Success: false,
+				Message: "Quiz not found",
+			}, nil
+		}
+		return &proto.SubmitQuizResponse{
+			Success: false,
+			Message: "Database error",
+		}, err
+	}
+	if time.Since(session.CreatedAt) > quizSessionTTL {
+		return &proto.SubmitQuizResponse{
+			Success: false,
+			Message: "Quiz has expired. Generate a new one",
+		}, nil
+	}
+	if req.Direction != "" && req.Direction != session.Direction {
+		return &proto.SubmitQuizResponse{
+			Success: false,
+			Message: fmt.Sprintf("Direction does not match the quiz, which is %s", session.Direction),
+		}, nil
+	}
+
+	if len(req.Answers) == 0 {
+		return &proto.SubmitQuizResponse{
+			Success: false,
+			Message: "At least one answer is required",
+		}, nil
+	}
+
+	ids := make([]uint32, len(req.Answers))
+	for i, answer := range req.Answers {
+		ids[i] = answer.VocabularyId
+	}
+
+	var entries []models.Vocabulary
+	if err := database.DB.Preload("Tags").Where("id IN ? AND user_id = ?", ids, authenticatedUserID).Find(&entries).Error; err != nil {
+		return &proto.SubmitQuizResponse{
+			Success: false,
+			Message: "Failed to fetch vocabularies",
+		}, err
+	}
+
+	entriesByID := make(map[uint32]*models.Vocabulary, len(entries))
+	for i := range entries {
+		entriesByID[uint32(entries[i].ID)] = &entries[i]
+	}
+	for _, id := range ids {
+		if entriesByID[id] == nil {
+			return &proto.SubmitQuizResponse{
+				Success: false,
+				Message: fmt.Sprintf("Vocabulary %d not found", id),
+			}, nil
+		}
+	}
+
+	machine, err := loadStageMachine(database.DB, uint(authenticatedUserID))
+	if err != nil {
+		return &proto.SubmitQuizResponse{
+			Success: false,
+			Message: "Failed to fetch stages",
+		}, err
+	}
+
+	// Grade every answer and feed it into the entry's review schedule. The
+	// quiz's questions stay locked until they are marked as answered, so
+	// concurrent submissions cannot grade a question twice.
+	now := time.Now()
+	results := make([]*proto.QuizResult, 0, len(req.Answers))
+	correct := 0
+	var rejection string
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		var questions []models.QuizQuestion
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("quiz_session_id = ?", session.ID).Find(&questions).Error; err != nil {
+			return err
+		}
+		questionsByID := make(map[uint32]*models.QuizQuestion, len(questions))
+		for i := range questions {
+			questionsByID[uint32(questions[i].VocabularyID)] = &questions[i]
+		}
+
+		answered := make([]uint, 0, len(req.Answers))
+		for _, answer := range req.Answers {
+			question := questionsByID[answer.VocabularyId]
+			switch {
+			case question == nil:
+				rejection = fmt.Sprintf("Vocabulary %d is not in this quiz", answer.VocabularyId)
+				return errQuizRejected
+			case question.AnsweredAt != nil:
+				rejection = fmt.Sprintf("Vocabulary %d has already been answered in this quiz", answer.VocabularyId)
+				return errQuizRejected
+			}
+			question.AnsweredAt = &now
+			answered = append(answered, question.ID)
+
+			entry := entriesByID[answer.VocabularyId]
+			_, expected := quizPromptAndAnswer(entry, session.Direction)
+			isCorrect := normalizeAnswer(answer.Answer) == normalizeAnswer(expected)
+
+			grade := gradeAgain
+			if isCorrect {
+				grade = gradeGood
+				correct++
+			}
+			if err := scheduleReview(entry, grade, now); err != nil {
+				return err
+			}
+			if err := saveReview(tx, machine, entry, grade, int(answer.ResponseTimeMs), models.RevisionQuiz); err != nil {
+				return err
+			}
+
+			results = append(results, &proto.QuizResult{
+				VocabularyId:  answer.VocabularyId,
+				Correct:       isCorrect,
+				Answer:        answer.Answer,
+				CorrectAnswer: expected,
+			})
+		}
+		return tx.Model(&models.QuizQuestion{}).Where("id IN ?", answered).Update("answered_at", now).Error
+	})
+	if errors.Is(err, errQuizRejected) {
+		return &proto.SubmitQuizResponse{
+			Success: false,
+			Message: rejection,
+		}, nil
+	}
+	if err != nil {
+		return &proto.SubmitQuizResponse{
+			Success: false,
+			Message: "Failed to record quiz results",
+		}, err
+	}
+
+	// Reload the graded entries, whose versions were bumped in the database
+	var reloaded []models.Vocabulary
+	if err := database.DB.Preload("Tags").Where("id IN ?", ids).Find(&reloaded).Error; err != nil {
+		return &proto.SubmitQuizResponse{
+			Success: false,
+			Message: "Failed to reload vocabularies",
+		}, err
+	}
+	for i := range reloaded {
+		entriesByID[uint32(reloaded[i].ID)] = &reloaded[i]
+	}
+	for _, result := range results {
+		result.Vocabulary = toProtoVocabulary(entriesByID[result.VocabularyId])
+	}
+
+	return &proto.SubmitQuizResponse{
+		Success: true,
+		Message: "Quiz graded successfully",
+		Correct: int32(correct),
+		Total:   int32(len(results)),
+		Score:   float64(correct) / float64(len(results)),
+		Results: results,
+	}, nil
+}
+
+// quizPromptAndAnswer returns the question prompt and the expected answer
+// for a vocabulary entry in the given quiz direction
+func quizPromptAndAnswer(vocab *models.Vocabulary, direction string) (string, string) {
+	if direction == quizMeaningToWord {
+		return vocab.Meaning, vocab.Word
+	}
+	return vocab.Word, vocab.Meaning
+}
+
+// normalizeAnswer lower-cases an answer and collapses its whitespace
+func normalizeAnswer(answer string) string {
+	return strings.ToLower(strings.Join(strings.Fields(answer), " "))
+}
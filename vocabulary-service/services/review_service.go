@@ -0,0 +1,355 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/vocal-tracker/vocabulary-service/database"
+	"github.com/vocal-tracker/vocabulary-service/middleware"
+	"github.com/vocal-tracker/vocabulary-service/models"
+	"github.com/vocal-tracker/vocabulary-service/proto"
+
+	"gorm.io/gorm"
+)
+
+// ReviewVocabulary implements the ReviewVocabulary RPC method
+func (s *VocabularyServiceImpl) ReviewVocabulary(ctx context.Context, req *proto.ReviewVocabularyRequest) (*proto.VocabularyResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Access denied: can only review your own vocabularies",
+		}, nil
+	}
+
+	var vocab models.Vocabulary
+	if err := database.DB.Where("id = ? AND user_id = ?", req.VocabularyId, authenticatedUserID).First(&vocab).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &proto.VocabularyResponse{
+				Success: false,
+				Message: "Vocabulary not found",
+			}, nil
+		}
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Database error",
+		}, err
+	}
+
+	if req.ResponseTimeMs < 0 {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Response time cannot be negative",
+		}, nil
+	}
+
+	machine, err := loadStageMachine(database.DB, vocab.UserID)
+	if err != nil {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Failed to fetch stages",
+		}, err
+	}
+
+	// Apply the SM-2 schedule for this grade
+	grade := strings.ToLower(req.Grade)
+	if err := scheduleReview(&vocab, grade, time.Now()); err != nil {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Invalid grade. Use again, hard, good or easy",
+		}, nil
+	}
+
+	if err := database.DB.Transaction(func(tx *gorm.DB) error {
+		return saveReview(tx, machine, &vocab, grade, int(req.ResponseTimeMs), models.RevisionReview)
+	}); err != nil {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Failed to record review",
+		}, err
+	}
+
+	// Reload the reviewed vocabulary
+	if err := database.DB.Preload("Tags").First(&vocab, vocab.ID).Error; err != nil {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Failed to reload vocabulary",
+		}, err
+	}
+
+	return &proto.VocabularyResponse{
+		Success:    true,
+		Message:    "Review recorded successfully",
+		Vocabulary: toProtoVocabulary(&vocab),
+	}, nil
+}
+
+// GetDueVocabularies implements the GetDueVocabularies RPC method
+func (s *VocabularyServiceImpl) GetDueVocabularies(ctx context.Context, req *proto.GetDueVocabulariesRequest) (*proto.GetDueVocabulariesResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.GetDueVocabulariesResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.GetDueVocabulariesResponse{
+			Success: false,
+			Message: "Access denied: can only access your own vocabularies",
+		}, nil
+	}
+
+	// Studying a single deck uses the deck's own limits when it has them
+	newLimit := int(req.NewLimit)
+	reviewLimit := int(req.ReviewLimit)
+	if req.DeckId > 0 {
+		deck, err := findDeck(authenticatedUserID, req.DeckId)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return &proto.GetDueVocabulariesResponse{
+					Success: false,
+					Message: "Deck not found",
+				}, nil
+			}
+			return &proto.GetDueVocabulariesResponse{
+				Success: false,
+				Message: "Database error",
+			}, err
+		}
+		if newLimit <= 0 {
+			newLimit = deck.NewCardsPerDay
+		}
+		if reviewLimit <= 0 {
+			reviewLimit = deck.ReviewCardsPerDay
+		}
+	}
+
+	// Fall back to the server's daily limits
+	if newLimit <= 0 {
+		newLimit = s.cfg.NewCardsPerDay
+	}
+	if reviewLimit <= 0 {
+		reviewLimit = s.cfg.ReviewCardsPerDay
+	}
+	inDeck := deckScope(req.DeckId)
+
+	now := time.Now()
+	dayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	// Cards already studied today count against the daily limits
+	var newStudied, reviewsDone int64
+	database.DB.Model(&models.Vocabulary{}).Scopes(inDeck).
+		Where("user_id = ? AND first_reviewed_at >= ?", authenticatedUserID, dayStart).
+		Count(&newStudied)
+	database.DB.Model(&models.Vocabulary{}).Scopes(inDeck).
+		Where("user_id = ? AND last_reviewed_at >= ? AND first_reviewed_at < ?", authenticatedUserID, dayStart, dayStart).
+		Count(&reviewsDone)
+
+	var totalDue int64
+	database.DB.Model(&models.Vocabulary{}).Scopes(inDeck).
+		Where("user_id = ? AND next_review_at <= ?", authenticatedUserID, now).
+		Count(&totalDue)
+
+	// Due reviews, most overdue first
+	var reviews []models.Vocabulary
+	if remaining := reviewLimit - int(reviewsDone); remaining > 0 {
+		if err := database.DB.Preload("Tags").Scopes(inDeck).Where("user_id = ? AND next_review_at <= ?", authenticatedUserID, now).
+			Order("next_review_at ASC").
+			Limit(remaining).
+			Find(&reviews).Error; err != nil {
+			return &proto.GetDueVocabulariesResponse{
+				Success: false,
+				Message: "Failed to fetch due vocabularies",
+			}, err
+		}
+	}
+
+	// New cards that have never been reviewed, oldest first
+	var newCards []models.Vocabulary
+	if remaining := newLimit - int(newStudied); remaining > 0 {
+		if err := database.DB.Preload("Tags").Scopes(inDeck).Where("user_id = ? AND next_review_at IS NULL", authenticatedUserID).
+			Order("created_at ASC").
+			Limit(remaining).
+			Find(&newCards).Error; err != nil {
+			return &proto.GetDueVocabulariesResponse{
+				Success: false,
+				Message: "Failed to fetch new vocabularies",
+			}, err
+		}
+	}
+
+	protoVocabs := make([]*proto.Vocabulary, 0, len(reviews)+len(newCards))
+	for i := range reviews {
+		protoVocabs = append(protoVocabs, toProtoVocabulary(&reviews[i]))
+	}
+	for i := range newCards {
+		protoVocabs = append(protoVocabs, toProtoVocabulary(&newCards[i]))
+	}
+
+	return &proto.GetDueVocabulariesResponse{
+		Success:      true,
+		Message:      "Due vocabularies retrieved successfully",
+		Vocabularies: protoVocabs,
+		ReviewCount:  int32(len(reviews)),
+		NewCount:     int32(len(newCards)),
+		TotalDue:     int32(totalDue),
+	}, nil
+}
+
+// GetReviewHistory implements the GetReviewHistory RPC method
+func (s *VocabularyServiceImpl) GetReviewHistory(ctx context.Context, req *proto.GetReviewHistoryRequest) (*proto.GetReviewHistoryResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.GetReviewHistoryResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.GetReviewHistoryResponse{
+			Success: false,
+			Message: "Access denied: can only access your own vocabularies",
+		}, nil
+	}
+
+	// Make sure the vocabulary belongs to the user
+	var vocab models.Vocabulary
+	if err := database.DB.Where("id = ? AND user_id = ?", req.VocabularyId, authenticatedUserID).First(&vocab).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &proto.GetReviewHistoryResponse{
+				Success: false,
+				Message: "Vocabulary not found",
+			}, nil
+		}
+		return &proto.GetReviewHistoryResponse{
+			Success: false,
+			Message: "Database error",
+		}, err
+	}
+
+	// Accuracy over every attempt, not just the returned page
+	var summary struct {
+		Total    int64
+		Failures int64
+	}
+	database.DB.Model(&models.ReviewAttempt{}).
+		Select("count(*) as total, count(*) filter (where grade = ?) as failures", gradeAgain).
+		Where("vocabulary_id = ?", vocab.ID).
+		Scan(&summary)
+
+	query := database.DB.Where("vocabulary_id = ?", vocab.ID).Order("reviewed_at DESC")
+	if req.Limit > 0 {
+		query = query.Limit(int(req.Limit))
+	}
+
+	var attempts []models.ReviewAttempt
+	if err := query.Find(&attempts).Error; err != nil {
+		return &proto.GetReviewHistoryResponse{
+			Success: false,
+			Message: "Failed to fetch review history",
+		}, err
+	}
+
+	protoAttempts := make([]*proto.ReviewAttempt, len(attempts))
+	for i, attempt := range attempts {
+		protoAttempts[i] = &proto.ReviewAttempt{
+			Id:             uint32(attempt.ID),
+			VocabularyId:   uint32(attempt.VocabularyID),
+			Grade:          attempt.Grade,
+			ResponseTimeMs: int32(attempt.ResponseTimeMs),
+			ReviewedAt:     attempt.ReviewedAt.Format(time.RFC3339),
+		}
+	}
+
+	return &proto.GetReviewHistoryResponse{
+		Success:      true,
+		Message:      "Review history retrieved successfully",
+		Attempts:     protoAttempts,
+		Total:        int32(summary.Total),
+		AccuracyRate: accuracyRate(summary.Total, summary.Failures),
+	}, nil
+}
+
+// saveReview persists the scheduling state of a reviewed vocabulary and
+// records the attempt in the review history. The review decides the status;
+// the entry moves to the stage of machine with that status closest to its
+// own, which is recorded as a revision and a transition from source, a
+// review or a quiz, if the stage changes.
+func saveReview(tx *gorm.DB, machine *stageMachine, vocab *models.Vocabulary, grade string, responseTimeMs int, source string) error {
+	from := vocab.Stage
+	stage := machine.closest(from, vocab.Status)
+	if stage.Name != from {
+		if revision := newRevision(vocab, map[string]interface{}{"stage": stage.Name}, source); revision != nil {
+			revision.CreatedAt = *vocab.LastReviewedAt
+			if err := tx.Create(revision).Error; err != nil {
+				return err
+			}
+		}
+		transition := models.StatusTransition{
+			VocabularyID: vocab.ID,
+			UserID:       vocab.UserID,
+			FromStage:    from,
+			ToStage:      stage.Name,
+			ToStatus:     stage.Status,
+			Source:       source,
+			CreatedAt:    *vocab.LastReviewedAt,
+		}
+		if current := machine.stage(from); current != nil {
+			transition.FromStatus = current.Status
+		}
+		if err := tx.Create(&transition).Error; err != nil {
+			return err
+		}
+	}
+	vocab.Stage = stage.Name
+
+	updates := map[string]interface{}{
+		"ease_factor":       vocab.EaseFactor,
+		"interval_days":     vocab.IntervalDays,
+		"repetitions":       vocab.Repetitions,
+		"next_review_at":    vocab.NextReviewAt,
+		"last_reviewed_at":  vocab.LastReviewedAt,
+		"first_reviewed_at": vocab.FirstReviewedAt,
+		"status":            vocab.Status,
+		"stage":             vocab.Stage,
+		"version":           nextVersion,
+	}
+	if err := tx.Model(vocab).Updates(updates).Error; err != nil {
+		return err
+	}
+
+	attempt := models.ReviewAttempt{
+		VocabularyID:   vocab.ID,
+		UserID:         vocab.UserID,
+		Grade:          grade,
+		ResponseTimeMs: responseTimeMs,
+		ReviewedAt:     *vocab.LastReviewedAt,
+	}
+	return tx.Create(&attempt).Error
+}
+
+// accuracyRate returns the share of attempts that were not failures
+func accuracyRate(total, failures int64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(total-failures) / float64(total)
+}
@@ -0,0 +1,317 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/vocal-tracker/vocabulary-service/database"
+	"github.com/vocal-tracker/vocabulary-service/middleware"
+	"github.com/vocal-tracker/vocabulary-service/models"
+	"github.com/vocal-tracker/vocabulary-service/proto"
+
+	"gorm.io/gorm"
+)
+
+// revisionFields are the fields of an entry whose changes are recorded as
+// revisions, in the order they are listed. The status recorded is the
+// entry's stage.
+var revisionFields = []string{"word", "meaning", "example", "status"}
+
+// GetVocabularyRevisions implements the GetVocabularyRevisions RPC method
+func (s *VocabularyServiceImpl) GetVocabularyRevisions(ctx context.Context, req *proto.GetVocabularyRevisionsRequest) (*proto.GetVocabularyRevisionsResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.GetVocabularyRevisionsResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.GetVocabularyRevisionsResponse{
+			Success: false,
+			Message: "Access denied: can only access your own vocabularies",
+		}, nil
+	}
+
+	// Make sure the vocabulary belongs to the user
+	var vocab models.Vocabulary
+	if err := database.DB.Where("id = ? AND user_id = ?", req.VocabularyId, authenticatedUserID).First(&vocab).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &proto.GetVocabularyRevisionsResponse{
+				Success: false,
+				Message: "Vocabulary not found",
+			}, nil
+		}
+		return &proto.GetVocabularyRevisionsResponse{
+			Success: false,
+			Message: "Database error",
+		}, err
+	}
+
+	var total int64
+	if err := database.DB.Model(&models.VocabularyRevision{}).Where("vocabulary_id = ?", vocab.ID).Count(&total).Error; err != nil {
+		return &proto.GetVocabularyRevisionsResponse{
+			Success: false,
+			Message: "Failed to fetch revisions",
+		}, err
+	}
+
+	query := database.DB.Where("vocabulary_id = ?", vocab.ID).Order("id DESC")
+	if req.Limit > 0 {
+		query = query.Limit(int(req.Limit))
+	}
+	if req.Offset > 0 {
+		query = query.Offset(int(req.Offset))
+	}
+
+	var revisions []models.VocabularyRevision
+	if err := query.Find(&revisions).Error; err != nil {
+		return &proto.GetVocabularyRevisionsResponse{
+			Success: false,
+			Message: "Failed to fetch revisions",
+		}, err
+	}
+
+	protoRevisions := make([]*proto.VocabularyRevision, len(revisions))
+	for i := range revisions {
+		protoRevisions[i] = toProtoRevision(&revisions[i])
+	}
+
+	return &proto.GetVocabularyRevisionsResponse{
+		Success:   true,
+		Message:   "Revisions retrieved successfully",
+		Revisions: protoRevisions,
+		Total:     int32(total),
+	}, nil
+}
+
+// RevertVocabulary implements the RevertVocabulary RPC method. The entry
+// gets back the values it had before the revision, undoing the revision and
+// every later one. The revert is itself recorded as a revision, so it can be
+// undone in turn.
+func (s *VocabularyServiceImpl) RevertVocabulary(ctx context.Context, req *proto.RevertVocabularyRequest) (*proto.VocabularyResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Access denied: can only revert your own vocabularies",
+		}, nil
+	}
+
+	var vocab models.Vocabulary
+	if err := database.DB.Where("id = ? AND user_id = ?", req.VocabularyId, authenticatedUserID).First(&vocab).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &proto.VocabularyResponse{
+				Success: false,
+				Message: "Vocabulary not found",
+			}, nil
+		}
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Database error",
+		}, err
+	}
+
+	// The revision and every later one, newest first
+	var revisions []models.VocabularyRevision
+	if err := database.DB.Where("vocabulary_id = ? AND id >= ?", vocab.ID, req.RevisionId).
+		Order("id DESC").Find(&revisions).Error; err != nil {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Failed to fetch revisions",
+		}, err
+	}
+	if len(revisions) == 0 || revisions[len(revisions)-1].ID != uint(req.RevisionId) {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Revision not found",
+		}, nil
+	}
+
+	// Undoing the revisions newest first leaves each field with its value
+	// from before the oldest revision that changed it
+	values := make(map[string]string)
+	for _, revision := range revisions {
+		for _, change := range revision.Changes {
+			values[change.Field] = change.OldValue
+		}
+	}
+	columns := make(map[string]interface{})
+	for _, field := range revisionFields {
+		if value, ok := values[field]; ok && value != vocabularyField(&vocab, field) {
+			columns[field] = value
+		}
+	}
+
+	// The entry goes back to the stage it had, which needs to still exist;
+	// it is not limited to the moves allowed from its current stage
+	if status, ok := columns["status"].(string); ok {
+		machine, err := loadStageMachine(database.DB, vocab.UserID)
+		if err != nil {
+			return &proto.VocabularyResponse{
+				Success: false,
+				Message: "Failed to fetch stages",
+			}, err
+		}
+		stage := machine.find(status, vocab.Stage)
+		if stage == nil {
+			return &proto.VocabularyResponse{
+				Success: false,
+				Message: fmt.Sprintf("Cannot revert to stage %q, which no longer exists", status),
+			}, nil
+		}
+		delete(columns, "status")
+		if stage.Name != vocab.Stage {
+			columns["stage"] = stage.Name
+			columns["status"] = stage.Status
+		}
+	}
+
+	var existing *models.Vocabulary
+	if err := database.DB.Transaction(func(tx *gorm.DB) error {
+		var err error
+		existing, err = saveVocabularyColumns(tx, &vocab, columns, models.RevisionRevert)
+		return err
+	}); err != nil {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Failed to revert vocabulary",
+		}, err
+	}
+
+	if existing != nil {
+		return &proto.VocabularyResponse{
+			Success:    false,
+			Message:    fmt.Sprintf("Vocabulary %q already exists; rename it to revert this entry", existing.Word),
+			Vocabulary: toProtoVocabulary(existing),
+			Duplicate:  true,
+		}, nil
+	}
+
+	// Reload the reverted vocabulary
+	if err := database.DB.Preload("Tags").First(&vocab, vocab.ID).Error; err != nil {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Failed to reload vocabulary",
+		}, err
+	}
+
+	return &proto.VocabularyResponse{
+		Success:    true,
+		Message:    "Vocabulary reverted successfully",
+		Vocabulary: toProtoVocabulary(&vocab),
+	}, nil
+}
+
+// saveVocabularyColumns sets columns on vocab and records the change as a
+// revision, and a change of stage as a transition, unless a new word is one
+// the user already has. In that case nothing is changed and the existing
+// entry is returned instead.
+func saveVocabularyColumns(tx *gorm.DB, vocab *models.Vocabulary, columns map[string]interface{}, source string) (*models.Vocabulary, error) {
+	if word, ok := columns["word"].(string); ok {
+		normalized := models.NormalizeWord(word)
+		if normalized != vocab.NormalizedWord {
+			existing, err := findDuplicate(tx, vocab.UserID, normalized, vocab.ID)
+			if err != nil || existing != nil {
+				return existing, err
+			}
+		}
+		columns["normalized_word"] = normalized
+	}
+	if len(columns) == 0 {
+		return nil, nil
+	}
+
+	columns["version"] = nextVersion
+
+	// The revision and transition are built before Updates copies the new
+	// values into vocab
+	if revision := newRevision(vocab, columns, source); revision != nil {
+		if err := tx.Create(revision).Error; err != nil {
+			return nil, err
+		}
+	}
+	if transition := newTransition(vocab, columns, source); transition != nil {
+		if err := tx.Create(transition).Error; err != nil {
+			return nil, err
+		}
+	}
+	return nil, tx.Model(vocab).Updates(columns).Error
+}
+
+// newRevision returns the revision of vocab that setting columns makes, or
+// nil if none of the recorded fields change
+func newRevision(vocab *models.Vocabulary, columns map[string]interface{}, source string) *models.VocabularyRevision {
+	var changes []models.FieldChange
+	for _, field := range revisionFields {
+		column := field
+		if field == "status" {
+			column = "stage"
+		}
+		value, ok := columns[column].(string)
+		if !ok {
+			continue
+		}
+		if old := vocabularyField(vocab, field); old != value {
+			changes = append(changes, models.FieldChange{Field: field, OldValue: old, NewValue: value})
+		}
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	return &models.VocabularyRevision{
+		VocabularyID: vocab.ID,
+		UserID:       vocab.UserID,
+		Source:       source,
+		Changes:      changes,
+	}
+}
+
+// vocabularyField returns the value of one of the revisionFields of vocab
+func vocabularyField(vocab *models.Vocabulary, field string) string {
+	switch field {
+	case "word":
+		return vocab.Word
+	case "meaning":
+		return vocab.Meaning
+	case "example":
+		return vocab.Example
+	case "status":
+		return vocab.Stage
+	}
+	return ""
+}
+
+// toProtoRevision converts a revision model to its proto representation
+func toProtoRevision(revision *models.VocabularyRevision) *proto.VocabularyRevision {
+	changes := make([]*proto.FieldChange, len(revision.Changes))
+	for i, change := range revision.Changes {
+		changes[i] = &proto.FieldChange{
+			Field:    change.Field,
+			OldValue: change.OldValue,
+			NewValue: change.NewValue,
+		}
+	}
+	return &proto.VocabularyRevision{
+		Id:           uint32(revision.ID),
+		VocabularyId: uint32(revision.VocabularyID),
+		UserId:       uint32(revision.UserID),
+		Source:       revision.Source,
+		Changes:      changes,
+		CreatedAt:    revision.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,328 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/vocal-tracker/vocabulary-service/database"
+	"github.com/vocal-tracker/vocabulary-service/middleware"
+	"github.com/vocal-tracker/vocabulary-service/models"
+	"github.com/vocal-tracker/vocabulary-service/proto"
+
+	"gorm.io/gorm"
+)
+
+const maxSavedSearchNameLength = 100
+
+// CreateSavedSearch implements the CreateSavedSearch RPC method
+func (s *VocabularyServiceImpl) CreateSavedSearch(ctx context.Context, req *proto.CreateSavedSearchRequest) (*proto.SavedSearchResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.SavedSearchResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.SavedSearchResponse{
+			Success: false,
+			Message: "Access denied: can only create saved searches for your own account",
+		}, nil
+	}
+
+	search := models.SavedSearch{
+		UserID: uint(authenticatedUserID),
+		Name:   strings.TrimSpace(req.Name),
+	}
+	setSavedSearchFilter(&search, req.Filter)
+	if message, err := validateSavedSearch(&search); message != "" || err != nil {
+		return &proto.SavedSearchResponse{
+			Success: false,
+			Message: message,
+		}, err
+	}
+
+	if err := database.DB.Create(&search).Error; err != nil {
+		return &proto.SavedSearchResponse{
+			Success: false,
+			Message: "Failed to create saved search",
+		}, err
+	}
+
+	return &proto.SavedSearchResponse{
+		Success:     true,
+		Message:     "Saved search created successfully",
+		SavedSearch: toProtoSavedSearch(&search),
+	}, nil
+}
+
+// GetSavedSearches implements the GetSavedSearches RPC method
+func (s *VocabularyServiceImpl) GetSavedSearches(ctx context.Context, req *proto.GetSavedSearchesRequest) (*proto.GetSavedSearchesResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.GetSavedSearchesResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.GetSavedSearchesResponse{
+			Success: false,
+			Message: "Access denied: can only access your own saved searches",
+		}, nil
+	}
+
+	var searches []models.SavedSearch
+	if err := database.DB.Where("user_id = ?", authenticatedUserID).Order("LOWER(name)").Find(&searches).Error; err != nil {
+		return &proto.GetSavedSearchesResponse{
+			Success: false,
+			Message: "Failed to fetch saved searches",
+		}, err
+	}
+
+	protoSearches := make([]*proto.SavedSearch, len(searches))
+	for i := range searches {
+		protoSearches[i] = toProtoSavedSearch(&searches[i])
+	}
+
+	return &proto.GetSavedSearchesResponse{
+		Success:       true,
+		Message:       "Saved searches retrieved successfully",
+		SavedSearches: protoSearches,
+	}, nil
+}
+
+// UpdateSavedSearch implements the UpdateSavedSearch RPC method
+func (s *VocabularyServiceImpl) UpdateSavedSearch(ctx context.Context, req *proto.UpdateSavedSearchRequest) (*proto.SavedSearchResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.SavedSearchResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.SavedSearchResponse{
+			Success: false,
+			Message: "Access denied: can only update your own saved searches",
+		}, nil
+	}
+
+	search, err := findSavedSearch(authenticatedUserID, req.SavedSearchId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &proto.SavedSearchResponse{
+				Success: false,
+				Message: "Saved search not found",
+			}, nil
+		}
+		return &proto.SavedSearchResponse{
+			Success: false,
+			Message: "Database error",
+		}, err
+	}
+
+	// Update fields if provided
+	if name := strings.TrimSpace(req.Name); name != "" {
+		search.Name = name
+	}
+	if req.Filter != nil {
+		setSavedSearchFilter(search, req.Filter)
+	}
+	if message, err := validateSavedSearch(search); message != "" || err != nil {
+		return &proto.SavedSearchResponse{
+			Success: false,
+			Message: message,
+		}, err
+	}
+
+	if err := database.DB.Save(search).Error; err != nil {
+		return &proto.SavedSearchResponse{
+			Success: false,
+			Message: "Failed to update saved search",
+		}, err
+	}
+
+	return &proto.SavedSearchResponse{
+		Success:     true,
+		Message:     "Saved search updated successfully",
+		SavedSearch: toProtoSavedSearch(search),
+	}, nil
+}
+
+// DeleteSavedSearch implements the DeleteSavedSearch RPC method
+func (s *VocabularyServiceImpl) DeleteSavedSearch(ctx context.Context, req *proto.SavedSearchRequest) (*proto.DeleteSavedSearchResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.DeleteSavedSearchResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.DeleteSavedSearchResponse{
+			Success: false,
+			Message: "Access denied: can only delete your own saved searches",
+		}, nil
+	}
+
+	result := database.DB.Where("id = ? AND user_id = ?", req.SavedSearchId, authenticatedUserID).Delete(&models.SavedSearch{})
+	if result.Error != nil {
+		return &proto.DeleteSavedSearchResponse{
+			Success: false,
+			Message: "Failed to delete saved search",
+		}, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return &proto.DeleteSavedSearchResponse{
+			Success: false,
+			Message: "Saved search not found",
+		}, nil
+	}
+
+	return &proto.DeleteSavedSearchResponse{
+		Success: true,
+		Message: "Saved search deleted successfully",
+	}, nil
+}
+
+// GetSavedSearchResults implements the GetSavedSearchResults RPC method. The
+// saved filters are run through GetVocabularies, so the results are the same
+// as listing with those filters now.
+func (s *VocabularyServiceImpl) GetSavedSearchResults(ctx context.Context, req *proto.GetSavedSearchResultsRequest) (*proto.GetVocabulariesResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.GetVocabulariesResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.GetVocabulariesResponse{
+			Success: false,
+			Message: "Access denied: can only access your own saved searches",
+		}, nil
+	}
+
+	search, err := findSavedSearch(authenticatedUserID, req.SavedSearchId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &proto.GetVocabulariesResponse{
+				Success: false,
+				Message: "Saved search not found",
+			}, nil
+		}
+		return &proto.GetVocabulariesResponse{
+			Success: false,
+			Message: "Database error",
+		}, err
+	}
+
+	filter := savedSearchFilter(search)
+	filter.UserId = authenticatedUserID
+	filter.Limit = req.Limit
+	filter.Offset = req.Offset
+	filter.SortBy = req.SortBy
+	filter.SortOrder = req.SortOrder
+	filter.PageToken = req.PageToken
+	return s.GetVocabularies(ctx, filter)
+}
+
+// setSavedSearchFilter copies the filters of a GetVocabularies request into
+// a saved search. A nil filter clears them.
+func setSavedSearchFilter(search *models.SavedSearch, filter *proto.GetVocabulariesRequest) {
+	if filter == nil {
+		filter = &proto.GetVocabulariesRequest{}
+	}
+	search.Date = strings.TrimSpace(filter.Date)
+	search.Search = strings.TrimSpace(filter.Search)
+	search.Query = strings.TrimSpace(filter.Q)
+	search.Status = filter.Status
+	search.Tags = filter.Tags
+	search.TagMatch = filter.TagMatch
+	search.DeckID = uint(filter.DeckId)
+}
+
+// savedSearchFilter returns the filters of a saved search as a GetVocabularies request
+func savedSearchFilter(search *models.SavedSearch) *proto.GetVocabulariesRequest {
+	return &proto.GetVocabulariesRequest{
+		Date:     search.Date,
+		Search:   search.Search,
+		Q:        search.Query,
+		Status:   search.Status,
+		Tags:     append([]string{}, search.Tags...),
+		TagMatch: search.TagMatch,
+		DeckId:   uint32(search.DeckID),
+	}
+}
+
+// validateSavedSearch checks the name and filters of a saved search,
+// returning a message for the user if they are invalid. Tag names are
+// normalised in place.
+func validateSavedSearch(search *models.SavedSearch) (string, error) {
+	if search.Name == "" {
+		return "Saved search name is required", nil
+	}
+	if utf8.RuneCountInString(search.Name) > maxSavedSearchNameLength {
+		return fmt.Sprintf("Saved search name must be at most %d characters", maxSavedSearchNameLength), nil
+	}
+
+	if message, err := validateVocabularyFilter(uint32(search.UserID), savedSearchFilter(search)); message != "" || err != nil {
+		return message, err
+	}
+	names, _ := normalizeTagNames(search.Tags)
+	search.Tags = names
+
+	// Saved search names are unique per user, ignoring case
+	var count int64
+	if err := database.DB.Model(&models.SavedSearch{}).
+		Where("user_id = ? AND LOWER(name) = LOWER(?) AND id <> ?", search.UserID, search.Name, search.ID).
+		Count(&count).Error; err != nil {
+		return "Database error", err
+	}
+	if count > 0 {
+		return fmt.Sprintf("A saved search named %q already exists", search.Name), nil
+	}
+	return "", nil
+}
+
+// findSavedSearch returns the user's saved search with the given ID
+func findSavedSearch(userID, searchID uint32) (*models.SavedSearch, error) {
+	var search models.SavedSearch
+	if err := database.DB.Where("id = ? AND user_id = ?", searchID, userID).First(&search).Error; err != nil {
+		return nil, err
+	}
+	return &search, nil
+}
+
+// toProtoSavedSearch converts a saved search to its proto form
+func toProtoSavedSearch(search *models.SavedSearch) *proto.SavedSearch {
+	return &proto.SavedSearch{
+		Id:        uint32(search.ID),
+		UserId:    uint32(search.UserID),
+		Name:      search.Name,
+		Filter:    savedSearchFilter(search),
+		CreatedAt: search.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: search.UpdatedAt.Format(time.RFC3339),
+	}
+}
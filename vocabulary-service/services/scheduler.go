@@ -0,0 +1,86 @@
+package services
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/vocal-tracker/vocabulary-service/models"
+)
+
+// Recall grades accepted by ReviewVocabulary
+const (
+	gradeAgain = "again"
+	gradeHard  = "hard"
+	gradeGood  = "good"
+	gradeEasy  = "easy"
+)
+
+const (
+	// minEaseFactor is the lowest ease factor SM-2 allows
+	minEaseFactor = 1.3
+	// masteredIntervalDays is the review interval at which a word counts as mastered
+	masteredIntervalDays = 21
+)
+
+// gradeQuality maps a recall grade to the SM-2 response quality (0-5)
+var gradeQuality = map[string]int{
+	gradeAgain: 1,
+	gradeHard:  3,
+	gradeGood:  4,
+	gradeEasy:  5,
+}
+
+var errInvalidGrade = errors.New("invalid grade")
+
+// scheduleReview applies an SM-2 review with the given grade to vocab and
+// updates its scheduling state and status as of now.
+func scheduleReview(vocab *models.Vocabulary, grade string, now time.Time) error {
+	quality, ok := gradeQuality[grade]
+	if !ok {
+		return errInvalidGrade
+	}
+
+	easeFactor := vocab.EaseFactor
+	if easeFactor == 0 {
+		easeFactor = 2.5
+	}
+
+	if quality < 3 {
+		// Failed recall: start the repetition sequence again from tomorrow
+		vocab.Repetitions = 0
+		vocab.IntervalDays = 1
+	} else {
+		switch vocab.Repetitions {
+		case 0:
+			vocab.IntervalDays = 1
+		case 1:
+			vocab.IntervalDays = 6
+		default:
+			vocab.IntervalDays = int(math.Round(float64(vocab.IntervalDays) * easeFactor))
+		}
+		vocab.Repetitions++
+	}
+
+	q := float64(5 - quality)
+	easeFactor += 0.1 - q*(0.08+q*0.02)
+	vocab.EaseFactor = math.Max(minEaseFactor, easeFactor)
+
+	nextReview := now.AddDate(0, 0, vocab.IntervalDays)
+	vocab.NextReviewAt = &nextReview
+	vocab.LastReviewedAt = &now
+	if vocab.FirstReviewedAt == nil {
+		vocab.FirstReviewedAt = &now
+	}
+
+	switch {
+	case quality < 3:
+		vocab.Status = models.StatusReviewNeeded
+	case vocab.IntervalDays >= masteredIntervalDays:
+		vocab.Status = models.StatusMastered
+	default:
+		vocab.Status = models.StatusLearned
+	}
+
+	return nil
+}
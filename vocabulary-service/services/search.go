@@ -0,0 +1,172 @@
+package services
+
+import (
+	"html"
+	"strings"
+
+	"github.com/vocal-tracker/vocabulary-service/database"
+	"github.com/vocal-tracker/vocabulary-service/models"
+
+	"gorm.io/gorm/clause"
+)
+
+// Markers around the matched text in search highlights. The rest of the
+// text is HTML-escaped, so clients can render highlights as they are.
+const (
+	highlightStart = "<mark>"
+	highlightEnd   = "</mark>"
+)
+
+// Markers ts_headline puts around the matched text, replaced with the
+// highlight markers once the text is escaped. They are control characters,
+// unlikely to be in an entry; one that is only adds a stray marker.
+const (
+	headlineStart = "\x02"
+	headlineEnd   = "\x03"
+)
+
+// headlineMarkers replaces the markers of ts_headline with the highlight
+// markers
+var headlineMarkers = strings.NewReplacer(headlineStart, highlightStart, headlineEnd, highlightEnd)
+
+// headlineSelectors are the ts_headline options choosing its markers
+const headlineSelectors = "StartSel=" + headlineStart + ", StopSel=" + headlineEnd
+
+// highlightOptions configures ts_headline for the meaning and example,
+// which may be long enough to need trimming to the matching fragments
+const highlightOptions = headlineSelectors + ", MaxFragments=2, MaxWords=20, MinWords=5"
+
+// searchPattern returns a search term as matched by similarity and
+// substring: lower-cased, without the quotes around phrases
+func searchPattern(search string) string {
+	return strings.ToLower(strings.TrimSpace(strings.ReplaceAll(search, `"`, "")))
+}
+
+// searchCondition matches entries for a search term. The search_vector index
+// (see database.Migrate) finds whole words in the word, meaning and example.
+// The trigram indexes find misspelt words, such as "ephemral" for
+// "ephemeral", and parts of words, with "%" and "_" matched literally.
+func searchCondition(search string) clause.Expr {
+	lowered := searchPattern(search)
+	pattern := "%" + likeEscaper.Replace(lowered) + "%"
+	return clause.Expr{
+		SQL: `(vocabularies.search_vector @@ websearch_to_tsquery('simple', ?)
+			OR LOWER(vocabularies.word) % ?
+			OR ? <% LOWER(vocabularies.meaning)
+			OR LOWER(vocabularies.word) LIKE ?
+			OR LOWER(vocabularies.meaning) LIKE ?
+			OR LOWER(vocabularies.example) LIKE ?)`,
+		Vars: []interface{}{search, lowered, lowered, pattern, pattern, pattern},
+	}
+}
+
+// searchRank orders entries by relevance to a search term: the full-text
+// rank, where matches in the word weigh most, plus how close the word is
+// to the term
+func searchRank(search string) clause.OrderBy {
+	lowered := searchPattern(search)
+	return clause.OrderBy{Expression: clause.Expr{
+		SQL: `ts_rank(vocabularies.search_vector, websearch_to_tsquery('simple', ?))
+			+ similarity(LOWER(vocabularies.word), ?) DESC, vocabularies.created_at DESC`,
+		Vars:               []interface{}{search, lowered},
+		WithoutParentheses: true,
+	}}
+}
+
+// searchHighlights returns the matches of a search term in each entry, by
+// entry ID and field. Fields without a match are left out.
+func searchHighlights(vocabularies []models.Vocabulary, search string) (map[uint]map[string]string, error) {
+	if len(vocabularies) == 0 {
+		return nil, nil
+	}
+	ids := make([]uint, len(vocabularies))
+	for i, vocab := range vocabularies {
+		ids[i] = vocab.ID
+	}
+
+	var rows []struct {
+		ID          uint
+		Word        string
+		Meaning     string
+		Example     string
+		WordSimilar bool
+	}
+	lowered := searchPattern(search)
+	if err := database.DB.Raw(`SELECT v.id,
+			ts_headline('simple', v.word, q, @wordOptions) AS word,
+			ts_headline('simple', v.meaning, q, @options) AS meaning,
+			ts_headline('simple', v.example, q, @options) AS example,
+			LOWER(v.word) % @lowered AS word_similar
+		FROM vocabularies v, websearch_to_tsquery('simple', @search) AS q
+		WHERE v.id IN @ids`,
+		map[string]interface{}{"wordOptions": "HighlightAll=true, " + headlineSelectors, "options": highlightOptions, "lowered": lowered, "search": search, "ids": ids}).
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	headlines := make(map[uint]int, len(rows))
+	for i, row := range rows {
+		headlines[row.ID] = i
+	}
+
+	highlights := make(map[uint]map[string]string)
+	for _, vocab := range vocabularies {
+		i, ok := headlines[vocab.ID]
+		if !ok {
+			continue
+		}
+		row := rows[i]
+
+		// Fall back to marking the term inside the full text, or the whole
+		// word when it only matched as a misspelling
+		word := markHeadline(row.Word)
+		if !strings.Contains(word, highlightStart) {
+			word = markSubstring(vocab.Word, lowered)
+			if !strings.Contains(word, highlightStart) && row.WordSimilar {
+				word = highlightStart + html.EscapeString(vocab.Word) + highlightEnd
+			}
+		}
+		meaning := markHeadline(row.Meaning)
+		if !strings.Contains(meaning, highlightStart) {
+			meaning = markSubstring(vocab.Meaning, lowered)
+		}
+		example := markHeadline(row.Example)
+		if !strings.Contains(example, highlightStart) {
+			example = markSubstring(vocab.Example, lowered)
+		}
+
+		fields := make(map[string]string)
+		for name, text := range map[string]string{"word": word, "meaning": meaning, "example": example} {
+			if strings.Contains(text, highlightStart) {
+				fields[name] = text
+			}
+		}
+		if len(fields) > 0 {
+			highlights[vocab.ID] = fields
+		}
+	}
+	return highlights, nil
+}
+
+// markHeadline escapes a headline from ts_headline and replaces its markers
+// with the highlight markers
+func markHeadline(headline string) string {
+	return headlineMarkers.Replace(html.EscapeString(headline))
+}
+
+// markSubstring escapes text and marks the first case-insensitive occurrence
+// of lowered in it. The text is split at the match before escaping, so the
+// markers never fall inside an escaped character such as "&amp;".
+func markSubstring(text, lowered string) string {
+	// Lower-casing can change the length of some characters, in which case
+	// offsets into the lower-cased text do not apply to the original
+	lowerText := strings.ToLower(text)
+	if lowered == "" || len(lowerText) != len(text) {
+		return html.EscapeString(text)
+	}
+	i := strings.Index(lowerText, lowered)
+	if i < 0 {
+		return html.EscapeString(text)
+	}
+	end := i + len(lowered)
+	return html.EscapeString(text[:i]) + highlightStart + html.EscapeString(text[i:end]) + highlightEnd + html.EscapeString(text[end:])
+}
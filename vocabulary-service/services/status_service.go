@@ -0,0 +1,556 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/vocal-tracker/vocabulary-service/database"
+	"github.com/vocal-tracker/vocabulary-service/middleware"
+	"github.com/vocal-tracker/vocabulary-service/models"
+	"github.com/vocal-tracker/vocabulary-service/proto"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// maxStatusStages is the number of custom stages a user can have
+	maxStatusStages = 10
+	// maxStageNameLength is the longest stage name allowed
+	maxStageNameLength = 30
+)
+
+const invalidStatusMessage = "Invalid status. Use review_needed, learned or mastered"
+
+// stageNamePattern is the form of a stage name, which has to fit in a filter
+// query such as "status:familiar"
+var stageNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+
+// statuses are the built-in statuses, in learning order
+var statuses = []string{models.StatusReviewNeeded, models.StatusLearned, models.StatusMastered}
+
+// statusValues maps the built-in statuses to their proto representation
+var statusValues = map[string]proto.VocabularyStatus{
+	models.StatusReviewNeeded: proto.VocabularyStatus_VOCABULARY_STATUS_REVIEW_NEEDED,
+	models.StatusLearned:      proto.VocabularyStatus_VOCABULARY_STATUS_LEARNED,
+	models.StatusMastered:     proto.VocabularyStatus_VOCABULARY_STATUS_MASTERED,
+}
+
+// builtinStages are the stages of users without custom stages, one per
+// status. An entry can move up to any later stage, but a mastered entry
+// that needs work goes back to review_needed.
+var builtinStages = []models.StatusStage{
+	{Name: models.StatusReviewNeeded, Status: models.StatusReviewNeeded, Position: 0, Next: []string{models.StatusLearned, models.StatusMastered}},
+	{Name: models.StatusLearned, Status: models.StatusLearned, Position: 1, Next: []string{models.StatusReviewNeeded, models.StatusMastered}},
+	{Name: models.StatusMastered, Status: models.StatusMastered, Position: 2, Next: []string{models.StatusReviewNeeded}},
+}
+
+// isValidStatus reports whether status is one of the built-in statuses
+func isValidStatus(status string) bool {
+	_, ok := statusValues[status]
+	return ok
+}
+
+// stageMachine is a user's learning stages, in order, and the moves allowed
+// between them. Every change of an entry's status made by the user is
+// checked against it; reviews move entries by their schedule.
+type stageMachine struct {
+	stages []models.StatusStage
+	custom bool
+}
+
+// loadStageMachine returns the user's custom stages, or the built-in ones
+// if the user has none
+func loadStageMachine(db *gorm.DB, userID uint) (*stageMachine, error) {
+	var stages []models.StatusStage
+	if err := db.Where("user_id = ?", userID).Order("position").Find(&stages).Error; err != nil {
+		return nil, err
+	}
+	if len(stages) == 0 {
+		return &stageMachine{stages: builtinStages}, nil
+	}
+	return &stageMachine{stages: stages, custom: true}, nil
+}
+
+// stage returns the stage with the given name, or nil if there is none
+func (m *stageMachine) stage(name string) *models.StatusStage {
+	for i := range m.stages {
+		if m.stages[i].Name == name {
+			return &m.stages[i]
+		}
+	}
+	return nil
+}
+
+// resolve returns the stage named by a status given by the user, matching
+// it case-insensitively. Errors are meant for the client.
+func (m *stageMachine) resolve(name string) (*models.StatusStage, error) {
+	stage := m.stage(strings.ToLower(strings.TrimSpace(name)))
+	if stage == nil {
+		return nil, fmt.Errorf("Unknown status %q. Use %s", name, joinOr(m.names()))
+	}
+	return stage, nil
+}
+
+// initial returns the stage a new entry starts at: the one named by
+// status, or the first stage if status is empty
+func (m *stageMachine) initial(status string) (*models.StatusStage, error) {
+	if strings.TrimSpace(status) == "" {
+		return &m.stages[0], nil
+	}
+	return m.resolve(status)
+}
+
+// checkMove returns an error for the client unless an entry at stage from
+// can move to stage to. An entry can always stay at its stage.
+func (m *stageMachine) checkMove(from string, to *models.StatusStage) error {
+	current := m.stage(from)
+	if from == to.Name || current == nil {
+		return nil
+	}
+	for _, next := range current.Next {
+		if next == to.Name {
+			return nil
+		}
+	}
+	return fmt.Errorf("Cannot move from %q to %q. From %q, use %s", from, to.Name, from, joinOr(current.Next))
+}
+
+// sources returns the names of the stages from which an entry can move to
+// stage to, including to itself
+func (m *stageMachine) sources(to *models.StatusStage) []string {
+	names := []string{to.Name}
+	for _, stage := range m.stages {
+		if stage.Name != to.Name && m.checkMove(stage.Name, to) == nil {
+			names = append(names, stage.Name)
+		}
+	}
+	return names
+}
+
+// closest returns the stage with the given status that is closest to stage
+// from, preferring the earlier of two. It is where a review that decides
+// the status of an entry moves it. Every status has a stage.
+func (m *stageMachine) closest(from, status string) *models.StatusStage {
+	position := 0
+	if current := m.stage(from); current != nil {
+		position = current.Position
+	}
+	var closest *models.StatusStage
+	for i := range m.stages {
+		stage := &m.stages[i]
+		if stage.Status != status {
+			continue
+		}
+		if closest == nil || abs(stage.Position-position) < abs(closest.Position-position) {
+			closest = stage
+		}
+	}
+	return closest
+}
+
+// find returns the stage named name or, if there is none and name is a
+// status, the stage with that status closest to stage from. It places
+// entries whose stage was recorded under other stages, such as archived or
+// reverted ones. It returns nil if name is neither.
+func (m *stageMachine) find(name, from string) *models.StatusStage {
+	if stage := m.stage(name); stage != nil {
+		return stage
+	}
+	if isValidStatus(name) {
+		return m.closest(from, name)
+	}
+	return nil
+}
+
+// names returns the names of the stages, in order
+func (m *stageMachine) names() []string {
+	names := make([]string, len(m.stages))
+	for i, stage := range m.stages {
+		names[i] = stage.Name
+	}
+	return names
+}
+
+// GetStatusStages implements the GetStatusStages RPC method
+func (s *VocabularyServiceImpl) GetStatusStages(ctx context.Context, req *proto.GetStatusStagesRequest) (*proto.StatusStagesResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.StatusStagesResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.StatusStagesResponse{
+			Success: false,
+			Message: "Access denied: can only access your own stages",
+		}, nil
+	}
+
+	machine, err := loadStageMachine(database.DB, uint(authenticatedUserID))
+	if err != nil {
+		return &proto.StatusStagesResponse{
+			Success: false,
+			Message: "Failed to fetch stages",
+		}, err
+	}
+
+	return &proto.StatusStagesResponse{
+		Success: true,
+		Message: "Stages retrieved successfully",
+		Stages:  toProtoStages(machine.stages),
+		Custom:  machine.custom,
+	}, nil
+}
+
+// SetStatusStages implements the SetStatusStages RPC method. The user's
+// stages are replaced, and entries move to the new ones: an entry whose
+// stage still exists keeps it, taking its status, and any other entry goes
+// to the first stage with its status. These moves are recorded as
+// transitions, so the progress timeline follows them.
+func (s *VocabularyServiceImpl) SetStatusStages(ctx context.Context, req *proto.SetStatusStagesRequest) (*proto.StatusStagesResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.StatusStagesResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.StatusStagesResponse{
+			Success: false,
+			Message: "Access denied: can only change your own stages",
+		}, nil
+	}
+
+	userID := uint(authenticatedUserID)
+	machine := &stageMachine{stages: builtinStages}
+	if len(req.Stages) > 0 {
+		stages := make([]models.StatusStage, len(req.Stages))
+		for i, stage := range req.Stages {
+			stages[i] = models.StatusStage{
+				UserID: userID,
+				Name:   stage.Name,
+				Status: fromProtoStatus(stage.Status),
+				Next:   stage.Next,
+			}
+		}
+		if message := validateStages(stages); message != "" {
+			return &proto.StatusStagesResponse{
+				Success: false,
+				Message: message,
+			}, nil
+		}
+		machine = &stageMachine{stages: stages, custom: true}
+	}
+
+	var updated int64
+	if err := database.DB.Transaction(func(tx *gorm.DB) error {
+		var err error
+		updated, err = saveStages(tx, userID, machine)
+		return err
+	}); err != nil {
+		return &proto.StatusStagesResponse{
+			Success: false,
+			Message: "Failed to save stages",
+		}, err
+	}
+
+	message := "Stages saved successfully"
+	if !machine.custom {
+		message = "Built-in stages restored successfully"
+	}
+	return &proto.StatusStagesResponse{
+		Success: true,
+		Message: message,
+		Stages:  toProtoStages(machine.stages),
+		Custom:  machine.custom,
+		Updated: int32(updated),
+	}, nil
+}
+
+// GetStatusTransitions implements the GetStatusTransitions RPC method
+func (s *VocabularyServiceImpl) GetStatusTransitions(ctx context.Context, req *proto.GetStatusTransitionsRequest) (*proto.GetStatusTransitionsResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.GetStatusTransitionsResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.GetStatusTransitionsResponse{
+			Success: false,
+			Message: "Access denied: can only access your own vocabularies",
+		}, nil
+	}
+
+	// Make sure the vocabulary belongs to the user
+	var vocab models.Vocabulary
+	if err := database.DB.Where("id = ? AND user_id = ?", req.VocabularyId, authenticatedUserID).First(&vocab).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &proto.GetStatusTransitionsResponse{
+				Success: false,
+				Message: "Vocabulary not found",
+			}, nil
+		}
+		return &proto.GetStatusTransitionsResponse{
+			Success: false,
+			Message: "Database error",
+		}, err
+	}
+
+	var total int64
+	if err := database.DB.Model(&models.StatusTransition{}).Where("vocabulary_id = ?", vocab.ID).Count(&total).Error; err != nil {
+		return &proto.GetStatusTransitionsResponse{
+			Success: false,
+			Message: "Failed to fetch transitions",
+		}, err
+	}
+
+	query := database.DB.Where("vocabulary_id = ?", vocab.ID).Order("created_at DESC, id DESC")
+	if req.Limit > 0 {
+		query = query.Limit(int(req.Limit))
+	}
+	if req.Offset > 0 {
+		query = query.Offset(int(req.Offset))
+	}
+
+	var transitions []models.StatusTransition
+	if err := query.Find(&transitions).Error; err != nil {
+		return &proto.GetStatusTransitionsResponse{
+			Success: false,
+			Message: "Failed to fetch transitions",
+		}, err
+	}
+
+	protoTransitions := make([]*proto.StatusTransition, len(transitions))
+	for i := range transitions {
+		protoTransitions[i] = toProtoTransition(&transitions[i])
+	}
+
+	return &proto.GetStatusTransitionsResponse{
+		Success:     true,
+		Message:     "Transitions retrieved successfully",
+		Transitions: protoTransitions,
+		Total:       int32(total),
+	}, nil
+}
+
+// validateStages checks a user's custom stages, in order, normalising their
+// names and filling in their positions and default next stages. It returns
+// a message for the user if they are invalid.
+func validateStages(stages []models.StatusStage) string {
+	if len(stages) > maxStatusStages {
+		return fmt.Sprintf("At most %d stages are allowed", maxStatusStages)
+	}
+
+	seen := make(map[string]bool, len(stages))
+	for i := range stages {
+		stage := &stages[i]
+		stage.Name = strings.ToLower(strings.TrimSpace(stage.Name))
+		stage.Position = i
+		switch {
+		case stage.Name == "":
+			return fmt.Sprintf("Stage %d: Stage name is required", i+1)
+		case len(stage.Name) > maxStageNameLength:
+			return fmt.Sprintf("Stage %q: Stage name must be at most %d characters", stage.Name, maxStageNameLength)
+		case !stageNamePattern.MatchString(stage.Name):
+			return fmt.Sprintf("Stage %q: Stage names start with a letter and use lowercase letters, digits, \"_\" and \"-\"", stage.Name)
+		case seen[stage.Name]:
+			return fmt.Sprintf("Stage %q appears more than once", stage.Name)
+		case !isValidStatus(stage.Status):
+			return fmt.Sprintf("Stage %q: %s", stage.Name, invalidStatusMessage)
+		case isValidStatus(stage.Name) && stage.Name != stage.Status:
+			return fmt.Sprintf("Stage %q must have status %s", stage.Name, stage.Name)
+		}
+		seen[stage.Name] = true
+	}
+
+	// Reviews move entries between statuses, so each needs a stage
+	for _, status := range statuses {
+		found := false
+		for _, stage := range stages {
+			found = found || stage.Status == status
+		}
+		if !found {
+			return fmt.Sprintf("No stage has status %s. Every status needs at least one stage", status)
+		}
+	}
+
+	for i := range stages {
+		stage := &stages[i]
+		if len(stage.Next) == 0 {
+			// By default an entry moves on to the next stage, or back to
+			// any earlier one
+			for j := i - 1; j >= 0; j-- {
+				stage.Next = append(stage.Next, stages[j].Name)
+			}
+			if i+1 < len(stages) {
+				stage.Next = append([]string{stages[i+1].Name}, stage.Next...)
+			}
+			continue
+		}
+
+		next := make([]string, 0, len(stage.Next))
+		listed := make(map[string]bool, len(stage.Next))
+		for _, name := range stage.Next {
+			name = strings.ToLower(strings.TrimSpace(name))
+			switch {
+			case !seen[name]:
+				return fmt.Sprintf("Stage %q: Unknown next stage %q", stage.Name, name)
+			case name == stage.Name:
+				return fmt.Sprintf("Stage %q cannot be its own next stage", stage.Name)
+			case listed[name]:
+				continue
+			}
+			listed[name] = true
+			next = append(next, name)
+		}
+		stage.Next = next
+	}
+	return ""
+}
+
+// saveStages replaces the user's stages with those of machine and moves the
+// user's entries, trashed ones included, to them, recording the moves as
+// transitions. It returns the number of entries moved.
+func saveStages(tx *gorm.DB, userID uint, machine *stageMachine) (int64, error) {
+	if err := tx.Where("user_id = ?", userID).Delete(&models.StatusStage{}).Error; err != nil {
+		return 0, err
+	}
+	if machine.custom {
+		if err := tx.Create(&machine.stages).Error; err != nil {
+			return 0, err
+		}
+	}
+
+	var updated int64
+	now := time.Now()
+	entries := tx.Model(&models.Vocabulary{}).Unscoped().Where("user_id = ?", userID)
+
+	// Entries keep a stage that still exists, taking its status
+	for _, stage := range machine.stages {
+		if err := tx.Exec("INSERT INTO status_transitions (vocabulary_id, user_id, from_stage, to_stage, from_status, to_status, source, created_at) "+
+			"SELECT id, user_id, stage, stage, status, ?, ?, ? FROM vocabularies WHERE user_id = ? AND stage = ? AND status <> ?",
+			stage.Status, models.TransitionStages, now, userID, stage.Name, stage.Status).Error; err != nil {
+			return 0, err
+		}
+		result := entries.Session(&gorm.Session{}).
+			Where("stage = ? AND status <> ?", stage.Name, stage.Status).
+			Updates(map[string]interface{}{"status": stage.Status, "version": nextVersion})
+		if result.Error != nil {
+			return 0, result.Error
+		}
+		updated += result.RowsAffected
+	}
+
+	// The others go to the first stage with their status
+	for _, status := range statuses {
+		first := machine.closest("", status)
+		if err := tx.Exec("INSERT INTO status_transitions (vocabulary_id, user_id, from_stage, to_stage, from_status, to_status, source, created_at) "+
+			"SELECT id, user_id, stage, ?, status, status, ?, ? FROM vocabularies WHERE user_id = ? AND status = ? AND stage NOT IN ?",
+			first.Name, models.TransitionStages, now, userID, status, machine.names()).Error; err != nil {
+			return 0, err
+		}
+		result := entries.Session(&gorm.Session{}).
+			Where("status = ? AND stage NOT IN ?", status, machine.names()).
+			Updates(map[string]interface{}{"stage": first.Name, "version": nextVersion})
+		if result.Error != nil {
+			return 0, result.Error
+		}
+		updated += result.RowsAffected
+	}
+	return updated, nil
+}
+
+// newTransition returns the transition of vocab that setting columns makes,
+// or nil if they do not change its stage
+func newTransition(vocab *models.Vocabulary, columns map[string]interface{}, source string) *models.StatusTransition {
+	stage, ok := columns["stage"].(string)
+	if !ok || stage == vocab.Stage {
+		return nil
+	}
+	status, _ := columns["status"].(string)
+	return &models.StatusTransition{
+		VocabularyID: vocab.ID,
+		UserID:       vocab.UserID,
+		FromStage:    vocab.Stage,
+		ToStage:      stage,
+		FromStatus:   vocab.Status,
+		ToStatus:     status,
+		Source:       source,
+	}
+}
+
+// toProtoStatus converts a status to its proto representation
+func toProtoStatus(status string) proto.VocabularyStatus {
+	return statusValues[status]
+}
+
+// fromProtoStatus converts a proto status to its name, or an empty string
+// if it is unspecified
+func fromProtoStatus(status proto.VocabularyStatus) string {
+	for name, value := range statusValues {
+		if value == status {
+			return name
+		}
+	}
+	return ""
+}
+
+// toProtoStages converts stages to their proto representation
+func toProtoStages(stages []models.StatusStage) []*proto.StatusStage {
+	protoStages := make([]*proto.StatusStage, len(stages))
+	for i, stage := range stages {
+		protoStages[i] = &proto.StatusStage{
+			Name:   stage.Name,
+			Status: toProtoStatus(stage.Status),
+			Next:   stage.Next,
+		}
+	}
+	return protoStages
+}
+
+// toProtoTransition converts a status transition to its proto representation
+func toProtoTransition(transition *models.StatusTransition) *proto.StatusTransition {
+	return &proto.StatusTransition{
+		Id:           uint32(transition.ID),
+		VocabularyId: uint32(transition.VocabularyID),
+		FromStage:    transition.FromStage,
+		ToStage:      transition.ToStage,
+		FromStatus:   toProtoStatus(transition.FromStatus),
+		ToStatus:     toProtoStatus(transition.ToStatus),
+		Source:       transition.Source,
+		CreatedAt:    transition.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// joinOr joins names for a message, e.g. "new, learning or mastered"
+func joinOr(names []string) string {
+	if len(names) < 2 {
+		return strings.Join(names, "")
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " or " + names[len(names)-1]
+}
+
+// abs returns the absolute value of n
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/vocal-tracker/vocabulary-service/database"
+	"github.com/vocal-tracker/vocabulary-service/middleware"
+	"github.com/vocal-tracker/vocabulary-service/models"
+	"github.com/vocal-tracker/vocabulary-service/proto"
+)
+
+// Suggestion orders
+const (
+	suggestSortRecent   = "recent"
+	suggestSortFrequent = "frequent"
+)
+
+const (
+	defaultSuggestLimit = 10
+	maxSuggestLimit     = 20
+	maxSuggestPrefix    = 100
+)
+
+// likeEscaper escapes the LIKE wildcards so a prefix matches literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// SuggestWords implements the SuggestWords RPC method. It runs a single query
+// on the (user_id, LOWER(word)) prefix index created by database.Migrate, so
+// it stays cheap enough to call on every keystroke. Reviews are counted only
+// for the suggestions returned, not for every match.
+func (s *VocabularyServiceImpl) SuggestWords(ctx context.Context, req *proto.SuggestWordsRequest) (*proto.SuggestWordsResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.SuggestWordsResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.SuggestWordsResponse{
+			Success: false,
+			Message: "Access denied: can only get suggestions from your own vocabularies",
+		}, nil
+	}
+
+	prefix := strings.ToLower(strings.TrimLeft(req.Prefix, " \t"))
+	if prefix == "" {
+		return &proto.SuggestWordsResponse{
+			Success: false,
+			Message: "Prefix is required",
+		}, nil
+	}
+	if utf8.RuneCountInString(prefix) > maxSuggestPrefix {
+		return &proto.SuggestWordsResponse{
+			Success: false,
+			Message: "Prefix must be at most 100 characters",
+		}, nil
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultSuggestLimit
+	}
+	if limit > maxSuggestLimit {
+		limit = maxSuggestLimit
+	}
+
+	// The orders use columns of vocabularies only, so they apply both to
+	// the matches and to the suggestions picked from them
+	var order string
+	switch req.Sort {
+	case "", suggestSortRecent:
+		order = "created_at DESC"
+	case suggestSortFrequent:
+		order = "repetitions DESC, created_at DESC"
+	default:
+		return &proto.SuggestWordsResponse{
+			Success: false,
+			Message: "Invalid sort. Use recent or frequent",
+		}, nil
+	}
+
+	var results []struct {
+		ID          uint
+		Word        string
+		Meaning     string
+		Status      string
+		ReviewCount int64
+	}
+	matches := database.DB.Model(&models.Vocabulary{}).
+		Select("id, word, meaning, status, repetitions, created_at").
+		Where("user_id = ? AND LOWER(word) LIKE ?", authenticatedUserID, likeEscaper.Replace(prefix)+"%").
+		Order(order).
+		Limit(limit)
+	if err := database.DB.Table("(?) AS suggestions", matches).
+		Select("suggestions.id, suggestions.word, suggestions.meaning, suggestions.status, " +
+			"(SELECT COUNT(*) FROM review_attempts ra WHERE ra.vocabulary_id = suggestions.id) AS review_count").
+		Order(order).
+		Scan(&results).Error; err != nil {
+		return &proto.SuggestWordsResponse{
+			Success: false,
+			Message: "Failed to fetch suggestions",
+		}, err
+	}
+
+	suggestions := make([]*proto.WordSuggestion, len(results))
+	for i, result := range results {
+		suggestions[i] = &proto.WordSuggestion{
+			Id:          uint32(result.ID),
+			Word:        result.Word,
+			Meaning:     result.Meaning,
+			Status:      toProtoStatus(result.Status),
+			ReviewCount: int32(result.ReviewCount),
+		}
+	}
+
+	return &proto.SuggestWordsResponse{
+		Success:     true,
+		Message:     "Suggestions retrieved successfully",
+		Suggestions: suggestions,
+	}, nil
+}
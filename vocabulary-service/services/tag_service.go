@@ -0,0 +1,259 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/vocal-tracker/vocabulary-service/database"
+	"github.com/vocal-tracker/vocabulary-service/middleware"
+	"github.com/vocal-tracker/vocabulary-service/models"
+	"github.com/vocal-tracker/vocabulary-service/proto"
+
+	"gorm.io/gorm"
+)
+
+const (
+	maxTagLength    = 50
+	maxTagsPerEntry = 20
+	// vocabularyTagsTable is the join table between vocabularies and tags
+	vocabularyTagsTable = "vocabulary_tags"
+)
+
+// ListTags implements the ListTags RPC method
+func (s *VocabularyServiceImpl) ListTags(ctx context.Context, req *proto.ListTagsRequest) (*proto.ListTagsResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.ListTagsResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.ListTagsResponse{
+			Success: false,
+			Message: "Access denied: can only list your own tags",
+		}, nil
+	}
+
+	var results []struct {
+		ID              uint
+		Name            string
+		VocabularyCount int64
+	}
+	if err := database.DB.Model(&models.Tag{}).
+		Select("tags.id, tags.name, count(vt.vocabulary_id) as vocabulary_count").
+		Joins("LEFT JOIN "+vocabularyTagsTable+" vt ON vt.tag_id = tags.id AND "+notTrashed("vt.vocabulary_id")).
+		Where("tags.user_id = ?", authenticatedUserID).
+		Group("tags.id, tags.name").
+		Order("LOWER(tags.name)").
+		Scan(&results).Error; err != nil {
+		return &proto.ListTagsResponse{
+			Success: false,
+			Message: "Failed to fetch tags",
+		}, err
+	}
+
+	tags := make([]*proto.Tag, len(results))
+	for i, result := range results {
+		tags[i] = &proto.Tag{
+			Id:              uint32(result.ID),
+			Name:            result.Name,
+			VocabularyCount: int32(result.VocabularyCount),
+		}
+	}
+
+	return &proto.ListTagsResponse{
+		Success: true,
+		Message: "Tags retrieved successfully",
+		Tags:    tags,
+	}, nil
+}
+
+// RenameTag implements the RenameTag RPC method
+func (s *VocabularyServiceImpl) RenameTag(ctx context.Context, req *proto.RenameTagRequest) (*proto.TagResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.TagResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.TagResponse{
+			Success: false,
+			Message: "Access denied: can only rename your own tags",
+		}, nil
+	}
+
+	names, err := normalizeTagNames([]string{req.Name})
+	if err != nil {
+		return &proto.TagResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	if len(names) == 0 {
+		return &proto.TagResponse{
+			Success: false,
+			Message: "Tag name is required",
+		}, nil
+	}
+	name := names[0]
+
+	var tag models.Tag
+	if err := database.DB.Where("id = ? AND user_id = ?", req.TagId, authenticatedUserID).First(&tag).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &proto.TagResponse{
+				Success: false,
+				Message: "Tag not found",
+			}, nil
+		}
+		return &proto.TagResponse{
+			Success: false,
+			Message: "Database error",
+		}, err
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		// Renaming onto another existing tag merges the two
+		var existing models.Tag
+		err := tx.Where("user_id = ? AND LOWER(name) = LOWER(?) AND id <> ?", authenticatedUserID, name, tag.ID).
+			First(&existing).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			tag.Name = name
+			return tx.Model(&tag).Update("name", name).Error
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Exec("INSERT INTO "+vocabularyTagsTable+" (vocabulary_id, tag_id) "+
+			"SELECT vocabulary_id, ? FROM "+vocabularyTagsTable+" WHERE tag_id = ? ON CONFLICT DO NOTHING",
+			existing.ID, tag.ID).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&tag).Error; err != nil {
+			return err
+		}
+		tag = existing
+		return nil
+	})
+	if err != nil {
+		return &proto.TagResponse{
+			Success: false,
+			Message: "Failed to rename tag",
+		}, err
+	}
+
+	var count int64
+	database.DB.Table(vocabularyTagsTable).Scopes(excludeTrashed("vocabulary_id")).Where("tag_id = ?", tag.ID).Count(&count)
+
+	return &proto.TagResponse{
+		Success: true,
+		Message: "Tag renamed successfully",
+		Tag: &proto.Tag{
+			Id:              uint32(tag.ID),
+			Name:            tag.Name,
+			VocabularyCount: int32(count),
+		},
+	}, nil
+}
+
+// DeleteTag implements the DeleteTag RPC method
+func (s *VocabularyServiceImpl) DeleteTag(ctx context.Context, req *proto.DeleteTagRequest) (*proto.DeleteTagResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.DeleteTagResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.DeleteTagResponse{
+			Success: false,
+			Message: "Access denied: can only delete your own tags",
+		}, nil
+	}
+
+	// Entries keep existing; the join rows are removed by the cascade
+	result := database.DB.Where("id = ? AND user_id = ?", req.TagId, authenticatedUserID).Delete(&models.Tag{})
+	if result.Error != nil {
+		return &proto.DeleteTagResponse{
+			Success: false,
+			Message: "Failed to delete tag",
+		}, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return &proto.DeleteTagResponse{
+			Success: false,
+			Message: "Tag not found",
+		}, nil
+	}
+
+	return &proto.DeleteTagResponse{
+		Success: true,
+		Message: "Tag deleted successfully",
+	}, nil
+}
+
+// normalizeTagNames trims and collapses the whitespace in each name, drops
+// empty names and removes duplicates that differ only in case
+func normalizeTagNames(names []string) ([]string, error) {
+	seen := make(map[string]bool, len(names))
+	normalized := make([]string, 0, len(names))
+	for _, name := range names {
+		name = strings.Join(strings.Fields(name), " ")
+		if name == "" || seen[strings.ToLower(name)] {
+			continue
+		}
+		if utf8.RuneCountInString(name) > maxTagLength {
+			return nil, fmt.Errorf("Tag %q is longer than %d characters", name, maxTagLength)
+		}
+		seen[strings.ToLower(name)] = true
+		normalized = append(normalized, name)
+	}
+	if len(normalized) > maxTagsPerEntry {
+		return nil, fmt.Errorf("An entry can have at most %d tags", maxTagsPerEntry)
+	}
+	return normalized, nil
+}
+
+// resolveTags returns the user's tags with the given names, creating any
+// that do not exist yet. Existing tags keep their original spelling.
+func resolveTags(tx *gorm.DB, userID uint, names []string) ([]models.Tag, error) {
+	tags := make([]models.Tag, len(names))
+	for i, name := range names {
+		if err := tx.Where("user_id = ? AND LOWER(name) = LOWER(?)", userID, name).
+			Attrs(models.Tag{UserID: userID, Name: name}).
+			FirstOrCreate(&tags[i]).Error; err != nil {
+			return nil, err
+		}
+	}
+	return tags, nil
+}
+
+// tagNames returns the names of tags sorted case-insensitively
+func tagNames(tags []models.Tag) []string {
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return strings.ToLower(names[i]) < strings.ToLower(names[j])
+	})
+	return names
+}
@@ -0,0 +1,245 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/vocal-tracker/vocabulary-service/database"
+	"github.com/vocal-tracker/vocabulary-service/middleware"
+	"github.com/vocal-tracker/vocabulary-service/models"
+	"github.com/vocal-tracker/vocabulary-service/proto"
+
+	"gorm.io/gorm"
+)
+
+// trashPurgeInterval is how often entries past the retention period are
+// purged from the trash
+const trashPurgeInterval = time.Hour
+
+// notTrashed returns a condition that the entry whose ID is in column is
+// not in the trash. GORM leaves trashed entries out of queries on
+// vocabularies; queries on the tables that reference them, such as review
+// attempts and tag and deck memberships, leave them out with this. Each
+// entry is looked up by its primary key, so the trash of other users is
+// never read.
+func notTrashed(column string) string {
+	return "NOT EXISTS (SELECT 1 FROM vocabularies trashed WHERE trashed.id = " + column + " AND trashed.deleted_at IS NOT NULL)"
+}
+
+// excludeTrashed returns a scope leaving out the rows whose column refers
+// to an entry in the trash
+func excludeTrashed(column string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(notTrashed(column))
+	}
+}
+
+// ListTrash implements the ListTrash RPC method
+func (s *VocabularyServiceImpl) ListTrash(ctx context.Context, req *proto.ListTrashRequest) (*proto.ListTrashResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.ListTrashResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.ListTrashResponse{
+			Success: false,
+			Message: "Access denied: can only access your own trash",
+		}, nil
+	}
+
+	trashed := func(db *gorm.DB) *gorm.DB {
+		return db.Unscoped().Where("user_id = ? AND deleted_at IS NOT NULL", authenticatedUserID)
+	}
+
+	var total int64
+	if err := database.DB.Model(&models.Vocabulary{}).Scopes(trashed).Count(&total).Error; err != nil {
+		return &proto.ListTrashResponse{
+			Success: false,
+			Message: "Failed to fetch trash",
+		}, err
+	}
+
+	query := database.DB.Scopes(trashed).Preload("Tags").Order("deleted_at DESC, id DESC")
+	if req.Limit > 0 {
+		query = query.Limit(int(req.Limit))
+	}
+	if req.Offset > 0 {
+		query = query.Offset(int(req.Offset))
+	}
+
+	var vocabularies []models.Vocabulary
+	if err := query.Find(&vocabularies).Error; err != nil {
+		return &proto.ListTrashResponse{
+			Success: false,
+			Message: "Failed to fetch trash",
+		}, err
+	}
+
+	return &proto.ListTrashResponse{
+		Success:       true,
+		Message:       "Trash retrieved successfully",
+		Vocabularies:  toProtoVocabularies(vocabularies),
+		Total:         int32(total),
+		RetentionDays: int32(max(s.cfg.TrashRetentionDays, 0)),
+	}, nil
+}
+
+// RestoreVocabulary implements the RestoreVocabulary RPC method. An entry
+// cannot be restored while the user has another entry with the same word.
+func (s *VocabularyServiceImpl) RestoreVocabulary(ctx context.Context, req *proto.RestoreVocabularyRequest) (*proto.VocabularyResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Access denied: can only restore your own vocabularies",
+		}, nil
+	}
+
+	var vocab models.Vocabulary
+	if err := database.DB.Unscoped().
+		Where("id = ? AND user_id = ? AND deleted_at IS NOT NULL", req.VocabularyId, authenticatedUserID).
+		First(&vocab).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &proto.VocabularyResponse{
+				Success: false,
+				Message: "Vocabulary not found in trash",
+			}, nil
+		}
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Database error",
+		}, err
+	}
+
+	existing, err := findDuplicate(database.DB, vocab.UserID, vocab.NormalizedWord, vocab.ID)
+	if err != nil {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Database error",
+		}, err
+	}
+	if existing != nil {
+		return &proto.VocabularyResponse{
+			Success:    false,
+			Message:    fmt.Sprintf("Vocabulary %q already exists; rename or delete it to restore this entry", existing.Word),
+			Vocabulary: toProtoVocabulary(existing),
+			Duplicate:  true,
+		}, nil
+	}
+
+	if err := database.DB.Unscoped().Model(&vocab).Updates(map[string]interface{}{
+		"deleted_at": nil,
+		"version":    nextVersion,
+	}).Error; err != nil {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Failed to restore vocabulary",
+		}, err
+	}
+
+	// Reload the restored vocabulary
+	if err := database.DB.Preload("Tags").First(&vocab, vocab.ID).Error; err != nil {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Failed to reload vocabulary",
+		}, err
+	}
+
+	return &proto.VocabularyResponse{
+		Success:    true,
+		Message:    "Vocabulary restored successfully",
+		Vocabulary: toProtoVocabulary(&vocab),
+	}, nil
+}
+
+// PurgeTrash implements the PurgeTrash RPC method
+func (s *VocabularyServiceImpl) PurgeTrash(ctx context.Context, req *proto.PurgeTrashRequest) (*proto.PurgeTrashResponse, error) {
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &proto.PurgeTrashResponse{
+			Success: false,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the request is for the authenticated user
+	if req.UserId != authenticatedUserID {
+		return &proto.PurgeTrashResponse{
+			Success: false,
+			Message: "Access denied: can only purge your own trash",
+		}, nil
+	}
+
+	// Review history, tags and deck memberships go with the entries
+	query := database.DB.Unscoped().Where("user_id = ? AND deleted_at IS NOT NULL", authenticatedUserID)
+	if len(req.VocabularyIds) > 0 {
+		query = query.Where("id IN ?", uniqueIDs(req.VocabularyIds))
+	}
+	result := query.Delete(&models.Vocabulary{})
+	if result.Error != nil {
+		return &proto.PurgeTrashResponse{
+			Success: false,
+			Message: "Failed to purge trash",
+		}, result.Error
+	}
+
+	if len(req.VocabularyIds) > 0 && result.RowsAffected == 0 {
+		return &proto.PurgeTrashResponse{
+			Success: false,
+			Message: "Vocabulary not found in trash",
+		}, nil
+	}
+
+	return &proto.PurgeTrashResponse{
+		Success: true,
+		Message: fmt.Sprintf("Purged %d entries from the trash", result.RowsAffected),
+		Purged:  int32(result.RowsAffected),
+	}, nil
+}
+
+// RunTrashPurge purges entries that have been in the trash longer than
+// retentionDays, at start and then every trashPurgeInterval, until ctx is
+// done. With a retention of 0 or less entries stay in the trash until they
+// are purged with PurgeTrash.
+func RunTrashPurge(ctx context.Context, retentionDays int) {
+	if retentionDays <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(trashPurgeInterval)
+	defer ticker.Stop()
+	for {
+		cutoff := time.Now().AddDate(0, 0, -retentionDays)
+		result := database.DB.Unscoped().Where("deleted_at < ?", cutoff).Delete(&models.Vocabulary{})
+		if result.Error != nil {
+			log.Printf("Failed to purge trash: %v", result.Error)
+		} else if result.RowsAffected > 0 {
+			log.Printf("Purged %d entries deleted before %s", result.RowsAffected, cutoff.Format(time.RFC3339))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -3,24 +3,30 @@ package services
 import (
 	"context"
 	"errors"
-	"log"
+	"fmt"
 	"strings"
 	"time"
 
+	"github.com/vocal-tracker/vocabulary-service/config"
 	"github.com/vocal-tracker/vocabulary-service/database"
 	"github.com/vocal-tracker/vocabulary-service/middleware"
 	"github.com/vocal-tracker/vocabulary-service/models"
 	"github.com/vocal-tracker/vocabulary-service/proto"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// hardestWordsLimit is the number of hardest words returned in statistics
+const hardestWordsLimit = 10
+
 type VocabularyServiceImpl struct {
 	proto.UnimplementedVocabularyServiceServer
+	cfg *config.Config
 }
 
-func NewVocabularyService() *VocabularyServiceImpl {
-	return &VocabularyServiceImpl{}
+func NewVocabularyService(cfg *config.Config) *VocabularyServiceImpl {
+	return &VocabularyServiceImpl{cfg: cfg}
 }
 
 // GetVocabularies implements the GetVocabularies RPC method
@@ -42,73 +48,99 @@ func (s *VocabularyServiceImpl) GetVocabularies(ctx context.Context, req *proto.
 		}, nil
 	}
 
-	var vocabularies []models.Vocabulary
-	query := database.DB.Where("user_id = ?", authenticatedUserID)
+	if _, err := compileQuery(req.Q, time.Now()); err != nil {
+		return &proto.GetVocabulariesResponse{
+			Success:       false,
+			Message:       err.Error(),
+			ErrorPosition: int32(err.pos),
+		}, nil
+	}
+	if message, err := validateVocabularyFilter(authenticatedUserID, req); message != "" || err != nil {
+		return &proto.GetVocabulariesResponse{
+			Success: false,
+			Message: message,
+		}, err
+	}
 
-	// Filter by date if provided
-	if req.Date != "" {
-		if date, err := time.Parse("2006-01-02", req.Date); err == nil {
-			query = query.Where("date = ?", date)
-		}
+	search := searchText(req)
+	sort, message := parseVocabularySort(req, search != "")
+	if message != "" {
+		return &proto.GetVocabulariesResponse{
+			Success: false,
+			Message: message,
+		}, nil
 	}
 
-	// Search filter
-	if req.Search != "" {
-		searchTerm := "%" + strings.ToLower(req.Search) + "%"
-		query = query.Where("LOWER(word) LIKE ? OR LOWER(meaning) LIKE ?", searchTerm, searchTerm)
+	var vocabularies []models.Vocabulary
+	query := database.DB.Scopes(filterVocabularies(authenticatedUserID, req)).Preload("Tags").Clauses(sort.orderBy(search))
+
+	// A page token continues after the previous page; otherwise offset applies
+	if req.PageToken != "" {
+		after, message := sort.after(req.PageToken)
+		if message != "" {
+			return &proto.GetVocabulariesResponse{
+				Success: false,
+				Message: message,
+			}, nil
+		}
+		query = query.Where(after)
+	} else if req.Offset > 0 {
+		query = query.Offset(int(req.Offset))
 	}
 
-	// Apply limit and offset
+	// Fetching one entry more than the limit tells whether there is a next page
 	if req.Limit > 0 {
-		query = query.Limit(int(req.Limit))
-	}
-	if req.Offset > 0 {
-		query = query.Offset(int(req.Offset))
+		query = query.Limit(int(req.Limit) + 1)
 	}
 
 	// Get total count for pagination
 	var total int64
-	countQuery := database.DB.Model(&models.Vocabulary{}).Where("user_id = ?", authenticatedUserID)
-	if req.Date != "" {
-		if date, err := time.Parse("2006-01-02", req.Date); err == nil {
-			countQuery = countQuery.Where("date = ?", date)
-		}
-	}
-	if req.Search != "" {
-		searchTerm := "%" + strings.ToLower(req.Search) + "%"
-		countQuery = countQuery.Where("LOWER(word) LIKE ? OR LOWER(meaning) LIKE ?", searchTerm, searchTerm)
-	}
-	countQuery.Count(&total)
+	database.DB.Model(&models.Vocabulary{}).Scopes(filterVocabularies(authenticatedUserID, req)).Count(&total)
 
-	if err := query.Order("created_at DESC").Find(&vocabularies).Error; err != nil {
+	if err := query.Find(&vocabularies).Error; err != nil {
 		return &proto.GetVocabulariesResponse{
 			Success: false,
 			Message: "Failed to fetch vocabularies",
 		}, err
 	}
 
+	var nextPageToken string
+	if req.Limit > 0 && len(vocabularies) > int(req.Limit) {
+		vocabularies = vocabularies[:req.Limit]
+		if sort.by != sortByRelevance {
+			if nextPageToken, err = sort.token(&vocabularies[len(vocabularies)-1]); err != nil {
+				return &proto.GetVocabulariesResponse{
+					Success: false,
+					Message: "Failed to fetch vocabularies",
+				}, err
+			}
+		}
+	}
+
+	var highlights map[uint]map[string]string
+	if search != "" {
+		if highlights, err = searchHighlights(vocabularies, search); err != nil {
+			return &proto.GetVocabulariesResponse{
+				Success: false,
+				Message: "Failed to highlight search results",
+			}, err
+		}
+	}
+
 	// Convert to proto format
 	protoVocabs := make([]*proto.Vocabulary, len(vocabularies))
-	for i, vocab := range vocabularies {
-		protoVocabs[i] = &proto.Vocabulary{
-			Id:        uint32(vocab.ID),
-			UserId:    uint32(vocab.UserID),
-			Word:      vocab.Word,
-			Meaning:   vocab.Meaning,
-			Example:   vocab.Example,
-			Date:      vocab.Date.Format("2006-01-02"),
-			Status:    vocab.Status,
-			CreatedAt: vocab.CreatedAt.Format(time.RFC3339),
-			UpdatedAt: vocab.UpdatedAt.Format(time.RFC3339),
-		}
+	for i := range vocabularies {
+		protoVocabs[i] = toProtoVocabulary(&vocabularies[i])
+		protoVocabs[i].Highlights = highlights[vocabularies[i].ID]
 	}
 
 	return &proto.GetVocabulariesResponse{
-		Success:      true,
-		Message:      "Vocabularies retrieved successfully",
-		Vocabularies: protoVocabs,
-		Count:        int32(len(vocabularies)),
-		Total:        int32(total),
+		Success:       true,
+		Message:       "Vocabularies retrieved successfully",
+		Vocabularies:  protoVocabs,
+		Count:         int32(len(vocabularies)),
+		Total:         int32(total),
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
@@ -131,52 +163,93 @@ func (s *VocabularyServiceImpl) CreateVocabulary(ctx context.Context, req *proto
 		}, nil
 	}
 
-	// Parse date
-	date, err := time.Parse("2006-01-02", req.Date)
+	machine, err := loadStageMachine(database.DB, uint(authenticatedUserID))
+	if err != nil {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Failed to fetch stages",
+		}, err
+	}
+
+	vocab, err := newVocabulary(machine, authenticatedUserID, req.Word, req.Meaning, req.Example, req.Date, req.Status)
+	if err != nil {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	names, err := normalizeTagNames(req.Tags)
 	if err != nil {
 		return &proto.VocabularyResponse{
 			Success: false,
-			Message: "Invalid date format. Use YYYY-MM-DD",
+			Message: err.Error(),
 		}, nil
 	}
 
-	// Set default status if not provided
-	status := req.Status
-	if status == "" {
-		status = "review_needed"
+	onDuplicate := req.OnDuplicate
+	if onDuplicate == "" {
+		onDuplicate = duplicateReject
+	}
+	if onDuplicate != duplicateReject && onDuplicate != duplicateMerge {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Invalid on_duplicate. Use reject or merge",
+		}, nil
 	}
 
-	// Create vocabulary using authenticated user ID
-	vocab := models.Vocabulary{
-		UserID:  uint(authenticatedUserID),
-		Word:    req.Word,
-		Meaning: req.Meaning,
-		Example: req.Example,
-		Date:    date,
-		Status:  status,
+	// The entry can only be added to the user's own decks
+	var decks []models.Deck
+	if deckIDs := uniqueIDs(req.DeckIds); len(deckIDs) > 0 {
+		if err := database.DB.Where("id IN ? AND user_id = ?", deckIDs, authenticatedUserID).Find(&decks).Error; err != nil {
+			return &proto.VocabularyResponse{
+				Success: false,
+				Message: "Database error",
+			}, err
+		}
+		if len(decks) != len(deckIDs) {
+			return &proto.VocabularyResponse{
+				Success: false,
+				Message: "Deck not found",
+			}, nil
+		}
 	}
 
-	if err := database.DB.Create(&vocab).Error; err != nil {
+	vocab.Decks = decks
+
+	var existing *models.Vocabulary
+	if err := database.DB.Transaction(func(tx *gorm.DB) error {
+		var err error
+		existing, err = createVocabulary(tx, &vocab, names, onDuplicate)
+		return err
+	}); err != nil {
 		return &proto.VocabularyResponse{
 			Success: false,
 			Message: "Failed to create vocabulary",
 		}, err
 	}
 
+	if existing != nil {
+		if onDuplicate == duplicateMerge {
+			return &proto.VocabularyResponse{
+				Success:    true,
+				Message:    "Vocabulary merged into the existing entry",
+				Vocabulary: toProtoVocabulary(existing),
+				Merged:     true,
+			}, nil
+		}
+		return &proto.VocabularyResponse{
+			Success:    false,
+			Message:    fmt.Sprintf("Vocabulary %q already exists", existing.Word),
+			Vocabulary: toProtoVocabulary(existing),
+			Duplicate:  true,
+		}, nil
+	}
+
 	return &proto.VocabularyResponse{
-		Success: true,
-		Message: "Vocabulary created successfully",
-		Vocabulary: &proto.Vocabulary{
-			Id:        uint32(vocab.ID),
-			UserId:    uint32(vocab.UserID),
-			Word:      vocab.Word,
-			Meaning:   vocab.Meaning,
-			Example:   vocab.Example,
-			Date:      vocab.Date.Format("2006-01-02"),
-			Status:    vocab.Status,
-			CreatedAt: vocab.CreatedAt.Format(time.RFC3339),
-			UpdatedAt: vocab.UpdatedAt.Format(time.RFC3339),
-		},
+		Success:    true,
+		Message:    "Vocabulary created successfully",
+		Vocabulary: toProtoVocabulary(&vocab),
 	}, nil
 }
 
@@ -196,58 +269,92 @@ func (s *VocabularyServiceImpl) UpdateVocabulary(ctx context.Context, req *proto
 		}, err
 	}
 
-	// Update fields if provided
-	updates := make(map[string]interface{})
-	if req.Word != "" {
-		updates["word"] = req.Word
-	}
-	if req.Meaning != "" {
-		updates["meaning"] = req.Meaning
+	machine, err := loadStageMachine(database.DB, vocab.UserID)
+	if err != nil {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Failed to fetch stages",
+		}, err
 	}
-	if req.Example != "" {
-		updates["example"] = req.Example
+
+	update, err := newVocabularyUpdate(req, machine)
+	if err != nil {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
 	}
-	if req.Status != "" {
-		updates["status"] = req.Status
+
+	var existing *models.Vocabulary
+	var moveErr error
+	conflict := false
+	if err := database.DB.Transaction(func(tx *gorm.DB) error {
+		// The version check, the stage the status can move from and the
+		// revision recorded all depend on the current entry
+		if err := lockVocabulary(tx, &vocab); err != nil {
+			return err
+		}
+		if req.ExpectedVersion != 0 && vocab.Version != int(req.ExpectedVersion) {
+			conflict = true
+			return nil
+		}
+		if update.fields["status"] {
+			if moveErr = machine.checkMove(vocab.Stage, update.stage); moveErr != nil {
+				return nil
+			}
+		}
+		var err error
+		existing, err = updateVocabulary(tx, &vocab, update)
+		return err
+	}); err != nil {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: "Failed to update vocabulary",
+		}, err
 	}
 
-	log.Println(updates);
+	if existing != nil {
+		return &proto.VocabularyResponse{
+			Success:    false,
+			Message:    fmt.Sprintf("Vocabulary %q already exists", existing.Word),
+			Vocabulary: toProtoVocabulary(existing),
+			Duplicate:  true,
+		}, nil
+	}
 
-	if len(updates) > 0 {
-		if err := database.DB.Model(&vocab).Updates(updates).Error; err != nil {
-			return &proto.VocabularyResponse{
-				Success: false,
-				Message: "Failed to update vocabulary",
-			}, err
-		}
+	if moveErr != nil {
+		return &proto.VocabularyResponse{
+			Success: false,
+			Message: moveErr.Error(),
+		}, nil
 	}
 
 	// Reload the updated vocabulary
-	if err := database.DB.First(&vocab, vocab.ID).Error; err != nil {
+	if err := database.DB.Preload("Tags").First(&vocab, vocab.ID).Error; err != nil {
 		return &proto.VocabularyResponse{
 			Success: false,
 			Message: "Failed to reload vocabulary",
 		}, err
 	}
 
+	if conflict {
+		return &proto.VocabularyResponse{
+			Success:    false,
+			Message:    versionConflictMessage(req.ExpectedVersion, &vocab),
+			Vocabulary: toProtoVocabulary(&vocab),
+			Conflict:   true,
+		}, nil
+	}
+
 	return &proto.VocabularyResponse{
-		Success: true,
-		Message: "Vocabulary updated successfully",
-		Vocabulary: &proto.Vocabulary{
-			Id:        uint32(vocab.ID),
-			UserId:    uint32(vocab.UserID),
-			Word:      vocab.Word,
-			Meaning:   vocab.Meaning,
-			Example:   vocab.Example,
-			Date:      vocab.Date.Format("2006-01-02"),
-			Status:    vocab.Status,
-			CreatedAt: vocab.CreatedAt.Format(time.RFC3339),
-			UpdatedAt: vocab.UpdatedAt.Format(time.RFC3339),
-		},
+		Success:    true,
+		Message:    "Vocabulary updated successfully",
+		Vocabulary: toProtoVocabulary(&vocab),
 	}, nil
 }
 
-// DeleteVocabulary implements the DeleteVocabulary RPC method
+// DeleteVocabulary implements the DeleteVocabulary RPC method. The entry
+// is moved to the trash, from where it can be restored until it is purged.
 func (s *VocabularyServiceImpl) DeleteVocabulary(ctx context.Context, req *proto.DeleteVocabularyRequest) (*proto.DeleteVocabularyResponse, error) {
 	result := database.DB.Where("id = ? AND user_id = ?", req.VocabularyId, req.UserId).Delete(&models.Vocabulary{})
 	if result.Error != nil {
@@ -266,14 +373,14 @@ func (s *VocabularyServiceImpl) DeleteVocabulary(ctx context.Context, req *proto
 
 	return &proto.DeleteVocabularyResponse{
 		Success: true,
-		Message: "Vocabulary deleted successfully",
+		Message: "Vocabulary moved to trash",
 	}, nil
 }
 
 // GetVocabularyById implements the GetVocabularyById RPC method
 func (s *VocabularyServiceImpl) GetVocabularyById(ctx context.Context, req *proto.GetVocabularyByIdRequest) (*proto.VocabularyResponse, error) {
 	var vocab models.Vocabulary
-	if err := database.DB.Where("id = ? AND user_id = ?", req.VocabularyId, req.UserId).First(&vocab).Error; err != nil {
+	if err := database.DB.Preload("Tags").Where("id = ? AND user_id = ?", req.VocabularyId, req.UserId).First(&vocab).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return &proto.VocabularyResponse{
 				Success: false,
@@ -287,19 +394,9 @@ func (s *VocabularyServiceImpl) GetVocabularyById(ctx context.Context, req *prot
 	}
 
 	return &proto.VocabularyResponse{
-		Success: true,
-		Message: "Vocabulary retrieved successfully",
-		Vocabulary: &proto.Vocabulary{
-			Id:        uint32(vocab.ID),
-			UserId:    uint32(vocab.UserID),
-			Word:      vocab.Word,
-			Meaning:   vocab.Meaning,
-			Example:   vocab.Example,
-			Date:      vocab.Date.Format("2006-01-02"),
-			Status:    vocab.Status,
-			CreatedAt: vocab.CreatedAt.Format(time.RFC3339),
-			UpdatedAt: vocab.UpdatedAt.Format(time.RFC3339),
-		},
+		Success:    true,
+		Message:    "Vocabulary retrieved successfully",
+		Vocabulary: toProtoVocabulary(&vocab),
 	}, nil
 }
 
@@ -335,6 +432,22 @@ func (s *VocabularyServiceImpl) GetVocabularyStats(ctx context.Context, req *pro
 		statusCounts[result.Status] = int32(result.Count)
 	}
 
+	// Stage counts
+	stageCounts := make(map[string]int32)
+	var stageResults []struct {
+		Stage string
+		Count int64
+	}
+	database.DB.Model(&models.Vocabulary{}).
+		Select("stage, count(*) as count").
+		Where("user_id = ?", req.UserId).
+		Group("stage").
+		Find(&stageResults)
+
+	for _, result := range stageResults {
+		stageCounts[result.Stage] = int32(result.Count)
+	}
+
 	// Daily counts for the last 30 days
 	var dailyCounts []*proto.DailyCount
 	for i := 29; i >= 0; i-- {
@@ -352,13 +465,352 @@ func (s *VocabularyServiceImpl) GetVocabularyStats(ctx context.Context, req *pro
 		})
 	}
 
+	// Review analytics, limited to the requested date range
+	reviewsFrom := time.Time{}
+	if from, err := time.Parse("2006-01-02", req.DateFrom); err == nil {
+		reviewsFrom = from
+	}
+	reviewsTo := time.Now().AddDate(0, 0, 1)
+	if to, err := time.Parse("2006-01-02", req.DateTo); err == nil {
+		reviewsTo = to.AddDate(0, 0, 1)
+	}
+
+	var reviewSummary struct {
+		Total           int64
+		Failures        int64
+		AvgResponseTime float64
+	}
+	database.DB.Model(&models.ReviewAttempt{}).
+		Scopes(excludeTrashed("vocabulary_id")).
+		Select("count(*) as total, count(*) filter (where grade = ?) as failures, "+
+			"coalesce(avg(response_time_ms) filter (where response_time_ms > 0), 0) as avg_response_time", gradeAgain).
+		Where("user_id = ? AND reviewed_at >= ? AND reviewed_at < ?", req.UserId, reviewsFrom, reviewsTo).
+		Scan(&reviewSummary)
+
+	// Hardest words: lowest recall accuracy among words that have been forgotten
+	var hardestResults []struct {
+		VocabularyID uint
+		Word         string
+		Attempts     int64
+		Failures     int64
+	}
+	database.DB.Raw(`SELECT a.vocabulary_id, v.word, count(*) AS attempts,
+			count(*) FILTER (WHERE a.grade = @again) AS failures
+		FROM review_attempts a
+		JOIN vocabularies v ON v.id = a.vocabulary_id AND v.deleted_at IS NULL
+		WHERE a.user_id = @user AND a.reviewed_at >= @from AND a.reviewed_at < @to
+		GROUP BY a.vocabulary_id, v.word
+		HAVING count(*) FILTER (WHERE a.grade = @again) > 0
+		ORDER BY count(*) FILTER (WHERE a.grade = @again)::float / count(*) DESC, count(*) DESC
+		LIMIT @limit`,
+		map[string]interface{}{
+			"again": gradeAgain,
+			"user":  req.UserId,
+			"from":  reviewsFrom,
+			"to":    reviewsTo,
+			"limit": hardestWordsLimit,
+		}).Scan(&hardestResults)
+
+	hardestWords := make([]*proto.HardWord, len(hardestResults))
+	for i, result := range hardestResults {
+		hardestWords[i] = &proto.HardWord{
+			VocabularyId: uint32(result.VocabularyID),
+			Word:         result.Word,
+			Attempts:     int32(result.Attempts),
+			Failures:     int32(result.Failures),
+			AccuracyRate: accuracyRate(result.Attempts, result.Failures),
+		}
+	}
+
+	// Learning streaks and progress towards the daily goal
+	days, err := activityDays(req.UserId)
+	if err != nil {
+		return &proto.VocabularyStatsResponse{
+			Success: false,
+			Message: "Failed to compute streaks",
+		}, err
+	}
+	now := time.Now().UTC()
+	currentStreak, longestStreak := computeStreaks(days, now)
+
+	todayStart := now.Truncate(24 * time.Hour)
+	var addedToday, reviewedToday int64
+	database.DB.Model(&models.Vocabulary{}).Where("user_id = ? AND created_at >= ?", req.UserId, todayStart).Count(&addedToday)
+	database.DB.Model(&models.ReviewAttempt{}).
+		Scopes(excludeTrashed("vocabulary_id")).
+		Where("user_id = ? AND reviewed_at >= ?", req.UserId, todayStart).
+		Distinct("vocabulary_id").
+		Count(&reviewedToday)
+
 	return &proto.VocabularyStatsResponse{
-		Success:        true,
-		Message:        "Statistics retrieved successfully",
-		TotalWords:     int32(totalWords),
-		WordsThisWeek:  int32(wordsThisWeek),
-		WordsThisMonth: int32(wordsThisMonth),
-		StatusCounts:   statusCounts,
-		DailyCounts:    dailyCounts,
+		Success:               true,
+		Message:               "Statistics retrieved successfully",
+		TotalWords:            int32(totalWords),
+		WordsThisWeek:         int32(wordsThisWeek),
+		WordsThisMonth:        int32(wordsThisMonth),
+		StatusCounts:          statusCounts,
+		StageCounts:           stageCounts,
+		DailyCounts:           dailyCounts,
+		TotalReviews:          int32(reviewSummary.Total),
+		AccuracyRate:          accuracyRate(reviewSummary.Total, reviewSummary.Failures),
+		AverageResponseTimeMs: reviewSummary.AvgResponseTime,
+		HardestWords:          hardestWords,
+		CurrentStreak:         int32(currentStreak),
+		LongestStreak:         int32(longestStreak),
+		DailyGoal:             int32(dailyGoalFor(req.UserId)),
+		TodayProgress:         int32(addedToday + reviewedToday),
 	}, nil
 }
+
+// newVocabulary validates the fields of a new entry the same way for every
+// way of adding one, and places it at the stage named by status, the first
+// of machine by default. Errors are meant for the client.
+func newVocabulary(machine *stageMachine, userID uint32, word, meaning, example, date, status string) (models.Vocabulary, error) {
+	if strings.TrimSpace(word) == "" || strings.TrimSpace(meaning) == "" {
+		return models.Vocabulary{}, errors.New("Word and meaning are required")
+	}
+
+	// Parse date
+	parsed, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return models.Vocabulary{}, errors.New("Invalid date format. Use YYYY-MM-DD")
+	}
+
+	stage, err := machine.initial(status)
+	if err != nil {
+		return models.Vocabulary{}, err
+	}
+
+	return models.Vocabulary{
+		UserID:  uint(userID),
+		Word:    strings.TrimSpace(word),
+		Meaning: meaning,
+		Example: example,
+		Date:    parsed,
+		Status:  stage.Status,
+		Stage:   stage.Name,
+	}, nil
+}
+
+// createVocabulary inserts vocab with the named tags and records its first
+// transition, unless the user already has an entry with the same normalised
+// word. In that case nothing is inserted and the existing entry is returned
+// instead; with duplicateMerge the new meaning, example, tags and decks are
+// first merged into it.
+func createVocabulary(tx *gorm.DB, vocab *models.Vocabulary, tagNames []string, onDuplicate string) (*models.Vocabulary, error) {
+	vocab.NormalizedWord = models.NormalizeWord(vocab.Word)
+	existing, err := findDuplicate(tx, vocab.UserID, vocab.NormalizedWord, 0)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && onDuplicate != duplicateMerge {
+		return existing, nil
+	}
+
+	tags, err := resolveTags(tx, vocab.UserID, tagNames)
+	if err != nil {
+		return nil, err
+	}
+	vocab.Tags = tags
+
+	if existing != nil {
+		if err := mergeVocabularyInto(tx, existing, vocab); err != nil {
+			return nil, err
+		}
+		return existing, tx.Preload("Tags").First(existing, existing.ID).Error
+	}
+	if err := tx.Create(vocab).Error; err != nil {
+		return nil, err
+	}
+	return nil, tx.Create(&models.StatusTransition{
+		VocabularyID: vocab.ID,
+		UserID:       vocab.UserID,
+		ToStage:      vocab.Stage,
+		ToStatus:     vocab.Status,
+		Source:       models.TransitionCreate,
+		CreatedAt:    vocab.CreatedAt,
+	}).Error
+}
+
+// updateFields are the fields an UpdateVocabulary request can set, as named
+// in its update mask
+var updateFields = map[string]bool{"word": true, "meaning": true, "example": true, "status": true, "tags": true}
+
+// vocabularyUpdate is the validated change of an UpdateVocabulary request
+type vocabularyUpdate struct {
+	word     string
+	meaning  string
+	example  string
+	status   string
+	tagNames []string
+
+	// stage is the stage named by status, if the update sets it
+	stage *models.StatusStage
+
+	// fields are the fields the update sets; the others are left unchanged
+	fields map[string]bool
+}
+
+// newVocabularyUpdate validates the fields of an UpdateVocabulary request.
+// With an update mask the fields in it are set, even when empty; without
+// one the non-empty fields are. A new status must name one of the stages of
+// machine; whether the entry can move there is checked separately. Errors
+// are meant for the client.
+func newVocabularyUpdate(req *proto.UpdateVocabularyRequest, machine *stageMachine) (*vocabularyUpdate, error) {
+	names, err := normalizeTagNames(req.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	update := &vocabularyUpdate{
+		word:     strings.TrimSpace(req.Word),
+		meaning:  req.Meaning,
+		example:  req.Example,
+		status:   req.Status,
+		tagNames: names,
+		fields:   make(map[string]bool),
+	}
+
+	if paths := req.GetUpdateMask().GetPaths(); len(paths) > 0 {
+		for _, path := range paths {
+			if !updateFields[path] {
+				return nil, fmt.Errorf("Unknown field %q in update_mask. Use word, meaning, example, status or tags", path)
+			}
+			update.fields[path] = true
+		}
+		if update.fields["word"] && update.word == "" {
+			return nil, errors.New("Word cannot be empty")
+		}
+		if update.fields["meaning"] && strings.TrimSpace(update.meaning) == "" {
+			return nil, errors.New("Meaning cannot be empty")
+		}
+	} else {
+		update.fields["word"] = update.word != ""
+		update.fields["meaning"] = update.meaning != ""
+		update.fields["example"] = update.example != ""
+		update.fields["status"] = update.status != ""
+		update.fields["tags"] = len(names) > 0
+	}
+	if req.ClearTags {
+		update.fields["tags"] = true
+	}
+
+	if update.fields["status"] {
+		if update.stage, err = machine.resolve(update.status); err != nil {
+			return nil, err
+		}
+		update.status = update.stage.Name
+	}
+	return update, nil
+}
+
+// columns returns the columns the update sets, other than the word
+func (u *vocabularyUpdate) columns() map[string]interface{} {
+	columns := make(map[string]interface{})
+	if u.fields["meaning"] {
+		columns["meaning"] = u.meaning
+	}
+	if u.fields["example"] {
+		columns["example"] = u.example
+	}
+	if u.fields["status"] {
+		columns["stage"] = u.stage.Name
+		columns["status"] = u.stage.Status
+	}
+	return columns
+}
+
+// updateVocabulary applies update to vocab, unless it renames the entry to
+// a word the user already has. In that case nothing is changed and the
+// existing entry is returned instead.
+func updateVocabulary(tx *gorm.DB, vocab *models.Vocabulary, update *vocabularyUpdate) (*models.Vocabulary, error) {
+	columns := update.columns()
+	if update.fields["word"] {
+		columns["word"] = update.word
+	}
+	existing, err := saveVocabularyColumns(tx, vocab, columns, models.RevisionUpdate)
+	if err != nil || existing != nil {
+		return existing, err
+	}
+
+	// Replace the entry's tags if new ones were given or they were cleared
+	if update.fields["tags"] {
+		tags, err := resolveTags(tx, vocab.UserID, update.tagNames)
+		if err != nil {
+			return nil, err
+		}
+		if err := tx.Model(vocab).Association("Tags").Replace(tags); err != nil {
+			return nil, err
+		}
+		// A change of tags alone is a new version too
+		if len(columns) == 0 {
+			return nil, tx.Model(vocab).Update("version", nextVersion).Error
+		}
+	}
+	return nil, nil
+}
+
+// nextVersion is the update of the version column made by every change to
+// an entry
+var nextVersion = gorm.Expr("version + 1")
+
+// lockVocabulary reloads vocab and locks it until the end of tx, so that its
+// version cannot change between a check and the update that follows
+func lockVocabulary(tx *gorm.DB, vocab *models.Vocabulary) error {
+	return tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(vocab).Error
+}
+
+// versionConflictMessage explains that vocab is no longer at the expected version
+func versionConflictMessage(expected int32, vocab *models.Vocabulary) string {
+	return fmt.Sprintf("Vocabulary was changed since version %d; the current version is %d", expected, vocab.Version)
+}
+
+// toProtoVocabulary converts a vocabulary model to its proto representation
+func toProtoVocabulary(vocab *models.Vocabulary) *proto.Vocabulary {
+	return &proto.Vocabulary{
+		Id:              uint32(vocab.ID),
+		UserId:          uint32(vocab.UserID),
+		Word:            vocab.Word,
+		Meaning:         vocab.Meaning,
+		Example:         vocab.Example,
+		Date:            vocab.Date.Format("2006-01-02"),
+		Status:          toProtoStatus(vocab.Status),
+		Stage:           vocab.Stage,
+		CreatedAt:       vocab.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:       vocab.UpdatedAt.Format(time.RFC3339),
+		EaseFactor:      vocab.EaseFactor,
+		IntervalDays:    int32(vocab.IntervalDays),
+		Repetitions:     int32(vocab.Repetitions),
+		NextReviewAt:    formatOptionalTime(vocab.NextReviewAt),
+		LastReviewedAt:  formatOptionalTime(vocab.LastReviewedAt),
+		FirstReviewedAt: formatOptionalTime(vocab.FirstReviewedAt),
+		Tags:            tagNames(vocab.Tags),
+		DeletedAt:       formatOptionalTime(deletedAt(vocab)),
+		Version:         int32(vocab.Version),
+	}
+}
+
+// deletedAt returns when vocab was moved to the trash, or nil if it is not in it
+func deletedAt(vocab *models.Vocabulary) *time.Time {
+	if !vocab.DeletedAt.Valid {
+		return nil
+	}
+	return &vocab.DeletedAt.Time
+}
+
+// toProtoVocabularies converts vocabulary models to their proto representation
+func toProtoVocabularies(vocabs []models.Vocabulary) []*proto.Vocabulary {
+	protoVocabs := make([]*proto.Vocabulary, len(vocabs))
+	for i := range vocabs {
+		protoVocabs[i] = toProtoVocabulary(&vocabs[i])
+	}
+	return protoVocabs
+}
+
+// formatOptionalTime formats t as RFC3339, or returns an empty string if t is nil
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}